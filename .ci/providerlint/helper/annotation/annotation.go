@@ -0,0 +1,55 @@
+// Package annotation parses the code generation annotations, e.g. `// @SDKResource("aws_example_thing", name="Thing")`,
+// that the Terraform AWS Provider uses to register resources and data sources.
+package annotation
+
+import (
+	"go/ast"
+	"regexp"
+)
+
+const (
+	NameFrameworkDataSource = `FrameworkDataSource`
+	NameFrameworkResource   = `FrameworkResource`
+	NameSDKDataSource       = `SDKDataSource`
+	NameSDKResource         = `SDKResource`
+	NameTags                = `Tags`
+)
+
+var annotationRegexp = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`)
+
+// FuncDecl returns the annotation names and their (unparsed) arguments from a function's doc comments
+func FuncDecl(funcDecl *ast.FuncDecl) map[string]string {
+	annotations := make(map[string]string)
+
+	if funcDecl == nil || funcDecl.Doc == nil {
+		return annotations
+	}
+
+	for _, comment := range funcDecl.Doc.List {
+		if m := annotationRegexp.FindStringSubmatch(comment.Text); len(m) > 0 {
+			annotations[m[1]] = m[3]
+		}
+	}
+
+	return annotations
+}
+
+// IsDataSource returns if the annotations register a data source
+func IsDataSource(annotations map[string]string) bool {
+	return hasAny(annotations, NameFrameworkDataSource, NameSDKDataSource)
+}
+
+// IsResource returns if the annotations register a resource
+func IsResource(annotations map[string]string) bool {
+	return hasAny(annotations, NameFrameworkResource, NameSDKResource)
+}
+
+func hasAny(annotations map[string]string, names ...string) bool {
+	for _, name := range names {
+		if _, ok := annotations[name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,6 @@
+package errs
+
+const (
+	FuncNameIsA                     = `IsA`
+	FuncNameIsAErrorMessageContains = `IsAErrorMessageContains`
+)
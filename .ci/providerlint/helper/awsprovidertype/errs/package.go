@@ -0,0 +1,25 @@
+package errs
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `errs`
+	PackagePath = `github.com/hashicorp/terraform-provider-aws/internal/errs`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	switch e := e.(type) {
+	case *ast.IndexExpr:
+		return IsFunc(e.X, info, funcName)
+	case *ast.IndexListExpr:
+		return IsFunc(e.X, info, funcName)
+	}
+
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
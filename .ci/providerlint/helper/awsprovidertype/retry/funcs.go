@@ -0,0 +1,5 @@
+package retry
+
+const (
+	FuncNameNotFound = `NotFound`
+)
@@ -0,0 +1,26 @@
+package retry
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `retry`
+	PackagePath = `github.com/hashicorp/terraform-provider-aws/internal/retry`
+
+	// SDKPackagePath is the Terraform Plugin SDK V2 package whose error types are handled equivalently.
+	SDKPackagePath = `github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
+
+// IsNamedType returns if the type name matches and is from the package
+func IsNamedType(t *types.Named, typeName string) bool {
+	return astutils.IsPackageNamedType(t, PackagePath, typeName) || astutils.IsPackageNamedType(t, SDKPackagePath, typeName)
+}
@@ -0,0 +1,21 @@
+package retry
+
+import (
+	"go/types"
+)
+
+const (
+	TypeNameNotFoundError = `NotFoundError`
+)
+
+// IsTypeNotFoundError returns if the type is NotFoundError from the internal/retry or Plugin SDK V2 helper/retry package
+func IsTypeNotFoundError(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Named:
+		return IsNamedType(t, TypeNameNotFoundError)
+	case *types.Pointer:
+		return IsTypeNotFoundError(t.Elem())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,9 @@
+package tags
+
+const (
+	FuncNameTagsAttribute         = `TagsAttribute`
+	FuncNameTagsAttributeRequired = `TagsAttributeRequired`
+	FuncNameTagsSchema            = `TagsSchema`
+	FuncNameTagsSchemaComputed    = `TagsSchemaComputed`
+	FuncNameTagsSchemaForceNew    = `TagsSchemaForceNew`
+)
@@ -0,0 +1,23 @@
+package tags
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `tags`
+	PackagePath = `github.com/hashicorp/terraform-provider-aws/internal/tags`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
+
+// IsNamedType returns if the type name matches and is from the package
+func IsNamedType(t *types.Named, typeName string) bool {
+	return astutils.IsPackageNamedType(t, PackagePath, typeName)
+}
@@ -0,0 +1,21 @@
+package tags
+
+import (
+	"go/types"
+)
+
+const (
+	TypeNameMap = `Map`
+)
+
+// IsTypeMap returns if the type is Map from the internal/tags package
+func IsTypeMap(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Named:
+		return IsNamedType(t, TypeNameMap)
+	case *types.Pointer:
+		return IsTypeMap(t.Elem())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,10 @@
+package tfresource
+
+const (
+	FuncNameNewEmptyResultError       = `NewEmptyResultError`
+	FuncNameNotFound                  = `NotFound`
+	FuncNameRetryWhenAWSErrCodeEquals = `RetryWhenAWSErrCodeEquals`
+
+	// FuncNamePrefixRetry is the prefix of all retrying functions, e.g. RetryWhenAWSErrCodeEquals
+	FuncNamePrefixRetry = `Retry`
+)
@@ -0,0 +1,42 @@
+package tfresource
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `tfresource`
+	PackagePath = `github.com/hashicorp/terraform-provider-aws/internal/tfresource`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(unwrapIndexExpr(e), info, PackagePath, funcName)
+}
+
+// IsFuncWithPrefix returns if the function call is in the package and the function name has the prefix
+func IsFuncWithPrefix(e ast.Expr, info *types.Info, prefix string) bool {
+	selector, ok := unwrapIndexExpr(e).(*ast.SelectorExpr)
+
+	if !ok || !strings.HasPrefix(selector.Sel.Name, prefix) {
+		return false
+	}
+
+	return IsFunc(selector, info, selector.Sel.Name)
+}
+
+// unwrapIndexExpr returns the generic function expression from an instantiation, e.g. RetryWhenIsA[T, E]
+func unwrapIndexExpr(e ast.Expr) ast.Expr {
+	switch e := e.(type) {
+	case *ast.IndexExpr:
+		return e.X
+	case *ast.IndexListExpr:
+		return e.X
+	default:
+		return e
+	}
+}
@@ -0,0 +1,181 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/errs"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/retry"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for finder functions that do not return retry.NotFoundError
+
+The AWSR003 analyzer reports when a finder function (a function with a find
+prefix) handles an AWS "not found" error, e.g. via errs.IsA() or
+tfawserr.ErrCodeEquals(), but does not return a *retry.NotFoundError. Callers
+rely on tfresource.NotFound() to detect resources that no longer exist.
+`
+
+const analyzerName = "AWSR003"
+
+const tfawserrPackagePath = `github.com/hashicorp/aws-sdk-go-base/v2/tfawserr`
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+var notFoundRegexp = regexp.MustCompile(`(?i)(notfound|nosuch|doesnotexist|notexist)`)
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		funcDecl := n.(*ast.FuncDecl)
+
+		if funcDecl.Body == nil || !strings.HasPrefix(strings.ToLower(funcDecl.Name.Name), "find") {
+			return
+		}
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			ifStmt, ok := n.(*ast.IfStmt)
+
+			if !ok || !isNotFoundCheck(pass, ifStmt.Cond) {
+				return true
+			}
+
+			for _, stmt := range ifStmt.Body.List {
+				returnStmt, ok := stmt.(*ast.ReturnStmt)
+
+				if !ok || len(returnStmt.Results) == 0 {
+					continue
+				}
+
+				result := returnStmt.Results[len(returnStmt.Results)-1]
+
+				if isNotFoundError(pass, result) {
+					continue
+				}
+
+				if commentIgnorer.ShouldIgnore(analyzerName, returnStmt) {
+					continue
+				}
+
+				pass.Reportf(result.Pos(), "%s: finder should return *retry.NotFoundError for AWS not found errors", analyzerName)
+			}
+
+			return true
+		})
+	})
+
+	return nil, nil
+}
+
+// isNotFoundCheck returns if the expression contains a check for an AWS "not found" error.
+func isNotFoundCheck(pass *analysis.Pass, e ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		switch {
+		case errs.IsFunc(callExpr.Fun, pass.TypesInfo, errs.FuncNameIsA), errs.IsFunc(callExpr.Fun, pass.TypesInfo, errs.FuncNameIsAErrorMessageContains):
+			found = found || isNotFoundTypeArgument(pass, callExpr.Fun)
+		case astutils.IsPackageFunc(callExpr.Fun, pass.TypesInfo, tfawserrPackagePath, "ErrCodeEquals"),
+			astutils.IsPackageFunc(callExpr.Fun, pass.TypesInfo, tfawserrPackagePath, "ErrCodeContains"),
+			astutils.IsPackageFunc(callExpr.Fun, pass.TypesInfo, tfawserrPackagePath, "ErrMessageContains"):
+			if len(callExpr.Args) == 0 {
+				break
+			}
+
+			for _, arg := range callExpr.Args[1:] {
+				found = found || isNotFoundValue(pass, arg)
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isNotFoundTypeArgument returns if the type argument of a generic function call names a "not found" error type,
+// e.g. errs.IsA[*types.ResourceNotFoundException].
+func isNotFoundTypeArgument(pass *analysis.Pass, e ast.Expr) bool {
+	indexExpr, ok := e.(*ast.IndexExpr)
+
+	if !ok {
+		return false
+	}
+
+	t := pass.TypesInfo.TypeOf(indexExpr.Index)
+
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+
+	return ok && notFoundRegexp.MatchString(named.Obj().Name())
+}
+
+// isNotFoundValue returns if the expression is an AWS error code or message indicating "not found".
+func isNotFoundValue(pass *analysis.Pass, e ast.Expr) bool {
+	if tv, ok := pass.TypesInfo.Types[e]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return notFoundRegexp.MatchString(strings.ReplaceAll(constant.StringVal(tv.Value), " ", ""))
+	}
+
+	switch e := e.(type) {
+	case *ast.Ident:
+		return notFoundRegexp.MatchString(e.Name)
+	case *ast.SelectorExpr:
+		return notFoundRegexp.MatchString(e.Sel.Name)
+	}
+
+	return false
+}
+
+// isNotFoundError returns if the expression is, or is constructed from, a retry.NotFoundError.
+func isNotFoundError(pass *analysis.Pass, e ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if tfresource.IsFunc(n.Fun, pass.TypesInfo, tfresource.FuncNameNewEmptyResultError) {
+				found = true
+			}
+		case ast.Expr:
+			if retry.IsTypeNotFoundError(pass.TypesInfo.TypeOf(n)) {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
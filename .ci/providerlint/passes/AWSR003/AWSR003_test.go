@@ -0,0 +1,12 @@
+package AWSR003
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR003(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/hashicorp/terraform-provider-aws/internal/service/a")
+}
@@ -0,0 +1,9 @@
+package tfawserr
+
+func ErrCodeEquals(err error, codes ...string) bool {
+	return false
+}
+
+func ErrMessageContains(err error, code string, message string) bool {
+	return false
+}
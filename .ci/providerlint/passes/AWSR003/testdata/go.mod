@@ -0,0 +1,7 @@
+module github.com/hashicorp/terraform-provider-aws
+
+go 1.24.6
+
+require github.com/hashicorp/aws-sdk-go-base/v2 v2.0.0
+
+replace github.com/hashicorp/aws-sdk-go-base/v2 => ./aws-sdk-go-base
@@ -0,0 +1,5 @@
+package errs
+
+func IsA[T error](err error) bool {
+	return false
+}
@@ -0,0 +1,10 @@
+package retry
+
+type NotFoundError struct {
+	LastError   error
+	LastRequest any
+}
+
+func (e *NotFoundError) Error() string {
+	return "not found"
+}
@@ -0,0 +1,109 @@
+package a
+
+import (
+	"errors"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const errCodeNoSuchThing = "NoSuchThing"
+
+type ResourceNotFoundException struct{}
+
+func (e *ResourceNotFoundException) Error() string {
+	return "resource not found"
+}
+
+type ValidationException struct{}
+
+func (e *ValidationException) Error() string {
+	return "validation"
+}
+
+type Thing struct{}
+
+func get() (*Thing, error) {
+	return nil, errors.New("test")
+}
+
+/* Passing cases */
+
+func findThingByID(id string) (*Thing, error) {
+	output, err := get()
+
+	if errs.IsA[*ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: id,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(id)
+	}
+
+	return output, nil
+}
+
+func findThingByName(name string) (*Thing, error) {
+	output, err := get()
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchThing) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: name,
+		}
+	}
+
+	if errs.IsA[*ValidationException](err) {
+		return nil, err
+	}
+
+	return output, err
+}
+
+func getThing() (*Thing, error) {
+	output, err := get()
+
+	if errs.IsA[*ResourceNotFoundException](err) {
+		return nil, nil
+	}
+
+	return output, err
+}
+
+/* Comment ignored cases */
+
+func findThingByARN(arn string) (*Thing, error) {
+	output, err := get()
+
+	if errs.IsA[*ResourceNotFoundException](err) {
+		//lintignore:AWSR003
+		return nil, nil
+	}
+
+	return output, err
+}
+
+/* Failing cases */
+
+func findThingByTag(tag string) (*Thing, error) {
+	output, err := get()
+
+	if errs.IsA[*ResourceNotFoundException](err) {
+		return nil, nil // want "finder should return \\*retry.NotFoundError for AWS not found errors"
+	}
+
+	if tfawserr.ErrMessageContains(err, "InvalidParameter", "does not exist") {
+		return nil, err // want "finder should return \\*retry.NotFoundError for AWS not found errors"
+	}
+
+	return output, err
+}
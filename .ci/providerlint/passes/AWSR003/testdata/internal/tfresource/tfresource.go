@@ -0,0 +1,9 @@
+package tfresource
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+func NewEmptyResultError(lastRequest any) error {
+	return &retry.NotFoundError{LastRequest: lastRequest}
+}
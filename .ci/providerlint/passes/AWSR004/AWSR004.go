@@ -0,0 +1,252 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/retry"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for tfresource.NotFound() checks without other error checks
+
+The AWSR004 analyzer reports when an if statement checking
+tfresource.NotFound(err) (or retry.NotFound(err)) is not accompanied by a
+check of err != nil, either in the same if/else chain, in an enclosing if
+statement, or in the statements that follow. Without the additional check,
+other errors are silently ignored.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	nodeFilter := []ast.Node{
+		(*ast.IfStmt)(nil),
+	}
+
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		ifStmt := n.(*ast.IfStmt)
+		errObj := notFoundCheckObject(pass, ifStmt.Cond)
+
+		if errObj == nil {
+			return true
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, ifStmt) {
+			return true
+		}
+
+		if isBranchOnly(ifStmt.Body) || hasNilCheck(pass, ifStmt.Cond, errObj, token.NEQ) || hasErrCheckInElse(pass, ifStmt.Else, errObj) || hasErrCheckInParents(pass, stack, errObj) || hasErrCheckInFollowing(pass, stack, errObj) {
+			return true
+		}
+
+		pass.Reportf(ifStmt.Cond.Pos(), "%s: tfresource.NotFound() check should be accompanied by an error check", analyzerName)
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// notFoundCheckObject returns the error variable of a tfresource.NotFound(err) or retry.NotFound(err) call within the expression.
+func notFoundCheckObject(pass *analysis.Pass, e ast.Expr) types.Object {
+	var obj types.Object
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok || len(callExpr.Args) != 1 {
+			return obj == nil
+		}
+
+		if !tfresource.IsFunc(callExpr.Fun, pass.TypesInfo, tfresource.FuncNameNotFound) && !retry.IsFunc(callExpr.Fun, pass.TypesInfo, retry.FuncNameNotFound) {
+			return obj == nil
+		}
+
+		if ident, ok := ast.Unparen(callExpr.Args[0]).(*ast.Ident); ok {
+			obj = pass.TypesInfo.ObjectOf(ident)
+		}
+
+		return false
+	})
+
+	return obj
+}
+
+// isBranchOnly returns if the block only contains a branch statement, e.g. continue.
+func isBranchOnly(block *ast.BlockStmt) bool {
+	if len(block.List) != 1 {
+		return false
+	}
+
+	_, ok := block.List[0].(*ast.BranchStmt)
+
+	return ok
+}
+
+// hasErrCheckInElse returns if an if/else chain has a final else block or checks the error.
+func hasErrCheckInElse(pass *analysis.Pass, stmt ast.Stmt, errObj types.Object) bool {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		return true
+	case *ast.IfStmt:
+		return hasNilCheck(pass, stmt.Cond, errObj, token.NEQ) || hasErrCheckInElse(pass, stmt.Else, errObj)
+	}
+
+	return false
+}
+
+// hasErrCheckInParents returns if an enclosing if statement checks the error,
+// or if the check is within a retrying function, e.g. tfresource.RetryWhen().
+func hasErrCheckInParents(pass *analysis.Pass, stack []ast.Node, errObj types.Object) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.IfStmt:
+			if hasNilCheck(pass, node.Cond, errObj, token.NEQ) {
+				return true
+			}
+
+			// if err == nil { ... } else if tfresource.NotFound(err) { ... }
+			if node.Else == stack[i+1] && hasNilCheck(pass, node.Cond, errObj, token.EQL) {
+				return true
+			}
+		case *ast.CallExpr:
+			if tfresource.IsFuncWithPrefix(node.Fun, pass.TypesInfo, tfresource.FuncNamePrefixRetry) {
+				return true
+			}
+		case *ast.FuncDecl:
+			return false
+		}
+	}
+
+	return false
+}
+
+// hasErrCheckInFollowing returns if the statements following the if/else chain check or return the error.
+// Other if statements and loops between the two checks are skipped.
+func hasErrCheckInFollowing(pass *analysis.Pass, stack []ast.Node, errObj types.Object) bool {
+	// Find the top of any if/else chain.
+	i := len(stack) - 1
+	for i > 0 {
+		if parent, ok := stack[i-1].(*ast.IfStmt); ok && parent.Else == stack[i] {
+			i--
+			continue
+		}
+		break
+	}
+
+	if i == 0 {
+		return false
+	}
+
+	var stmts []ast.Stmt
+
+	switch parent := stack[i-1].(type) {
+	case *ast.BlockStmt:
+		stmts = parent.List
+	case *ast.CaseClause:
+		stmts = parent.Body
+	case *ast.CommClause:
+		stmts = parent.Body
+	default:
+		return false
+	}
+
+	var following []ast.Stmt
+	for j, stmt := range stmts {
+		if stmt == stack[i] {
+			following = stmts[j+1:]
+			break
+		}
+	}
+
+	for _, stmt := range following {
+		switch stmt := stmt.(type) {
+		case *ast.IfStmt:
+			if hasNilCheck(pass, stmt.Cond, errObj, token.NEQ) || hasErrCheckInElse(pass, stmt.Else, errObj) {
+				return true
+			}
+
+			// Other checks, e.g. if tfawserr.ErrCodeEquals(err, ...) { ... }
+			continue
+		case *ast.ForStmt, *ast.RangeStmt:
+			// e.g. retry loops which reassign the error.
+			continue
+		case *ast.ReturnStmt:
+			return usesObject(pass, stmt, errObj)
+		}
+
+		return false
+	}
+
+	return false
+}
+
+// hasNilCheck returns if the expression compares the error to nil with the given operator.
+func hasNilCheck(pass *analysis.Pass, e ast.Expr, errObj types.Object, op token.Token) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		binaryExpr, ok := n.(*ast.BinaryExpr)
+
+		if !ok || binaryExpr.Op != op {
+			return !found
+		}
+
+		x, y := ast.Unparen(binaryExpr.X), ast.Unparen(binaryExpr.Y)
+
+		if isObject(pass, x, errObj) && isNil(pass, y) || isObject(pass, y, errObj) && isNil(pass, x) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+func isNil(pass *analysis.Pass, e ast.Expr) bool {
+	return pass.TypesInfo.Types[e].IsNil()
+}
+
+func isObject(pass *analysis.Pass, e ast.Expr, obj types.Object) bool {
+	ident, ok := e.(*ast.Ident)
+
+	return ok && pass.TypesInfo.ObjectOf(ident) == obj
+}
+
+func usesObject(pass *analysis.Pass, n ast.Node, obj types.Object) bool {
+	var found bool
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && pass.TypesInfo.ObjectOf(ident) == obj {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,5 @@
+package retry
+
+func NotFound(err error) bool {
+	return false
+}
@@ -0,0 +1,111 @@
+package a
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func find() (any, error) {
+	return nil, errors.New("test")
+}
+
+func f(ctx context.Context, isNewResource bool) error {
+	/* Passing cases */
+
+	_, err := find()
+
+	if !isNewResource && tfresource.NotFound(err) {
+		log.Printf("[WARN] not found, removing from state")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if tfresource.NotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err == nil {
+		log.Printf("[DEBUG] found")
+	} else if tfresource.NotFound(err) {
+		log.Printf("[DEBUG] not found")
+	} else {
+		return err
+	}
+
+	if err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if retry.NotFound(err) {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for range 3 {
+		_, err := find()
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, time.Minute, func(ctx context.Context) (any, error) {
+		output, err := find()
+
+		if tfresource.NotFound(err) {
+			return nil, nil
+		}
+
+		return output, err
+	}, "ThrottlingException")
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func g() {
+	/* Comment ignored cases */
+
+	_, err := find()
+
+	//lintignore:AWSR004
+	if tfresource.NotFound(err) {
+		log.Printf("[DEBUG] not found")
+	}
+
+	/* Failing cases */
+
+	if tfresource.NotFound(err) { // want "tfresource.NotFound\\(\\) check should be accompanied by an error check"
+		log.Printf("[DEBUG] not found")
+	}
+
+	if retry.NotFound(err) { // want "tfresource.NotFound\\(\\) check should be accompanied by an error check"
+		return
+	}
+
+	log.Printf("[DEBUG] done")
+}
@@ -0,0 +1,14 @@
+package tfresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+var NotFound = retry.NotFound
+
+func RetryWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func(context.Context) (T, error), codes ...string) (T, error) {
+	return f(ctx)
+}
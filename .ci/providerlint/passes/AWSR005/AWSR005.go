@@ -0,0 +1,86 @@
+package AWSR005
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for misuse of tfresource.Retry*() functions
+
+The AWSR005 analyzer reports when:
+
+- the error returned by a tfresource.Retry*() function, e.g.
+  tfresource.RetryWhenAWSErrCodeEquals(), is discarded
+- tfresource.RetryWhenAWSErrCodeEquals() is called without any error codes,
+  in which case no error is retried
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		if commentIgnorer.ShouldIgnore(analyzerName, n) {
+			return
+		}
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 || len(n.Lhs) == 0 {
+				return
+			}
+
+			if !isRetryCall(pass, n.Rhs[0]) {
+				return
+			}
+
+			if ident, ok := n.Lhs[len(n.Lhs)-1].(*ast.Ident); ok && ident.Name == "_" {
+				pass.Reportf(ident.Pos(), "%s: error returned by tfresource retry function is discarded", analyzerName)
+			}
+		case *ast.CallExpr:
+			if !tfresource.IsFunc(n.Fun, pass.TypesInfo, tfresource.FuncNameRetryWhenAWSErrCodeEquals) {
+				return
+			}
+
+			// ctx, timeout, f, codes...
+			if len(n.Args) < 4 && !n.Ellipsis.IsValid() {
+				pass.Reportf(n.Rparen, "%s: tfresource.RetryWhenAWSErrCodeEquals() called without error codes", analyzerName)
+			}
+		case *ast.ExprStmt:
+			if isRetryCall(pass, n.X) {
+				pass.Reportf(n.Pos(), "%s: error returned by tfresource retry function is discarded", analyzerName)
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+func isRetryCall(pass *analysis.Pass, e ast.Expr) bool {
+	callExpr, ok := ast.Unparen(e).(*ast.CallExpr)
+
+	return ok && tfresource.IsFuncWithPrefix(callExpr.Fun, pass.TypesInfo, tfresource.FuncNamePrefixRetry)
+}
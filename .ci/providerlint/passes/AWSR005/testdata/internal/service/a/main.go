@@ -0,0 +1,50 @@
+package a
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func find(context.Context) (any, error) {
+	return nil, nil
+}
+
+func f(ctx context.Context) error {
+	/* Passing cases */
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, time.Minute, find, "ThrottlingException")
+
+	if err != nil {
+		return err
+	}
+
+	codes := []string{"ThrottlingException"}
+	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, time.Minute, find, codes...)
+
+	if err != nil {
+		return err
+	}
+
+	_ = output
+
+	if _, err := tfresource.RetryWhenNotFound(ctx, time.Minute, find); err != nil {
+		return err
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	tfresource.RetryUntilNotFound(ctx, time.Minute, find)
+
+	/* Failing cases */
+
+	tfresource.RetryUntilNotFound(ctx, time.Minute, find) // want "error returned by tfresource retry function is discarded"
+
+	output, _ = tfresource.RetryWhenNotFound(ctx, time.Minute, find) // want "error returned by tfresource retry function is discarded"
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, time.Minute, find) // want "tfresource.RetryWhenAWSErrCodeEquals\\(\\) called without error codes"
+
+	return err
+}
@@ -0,0 +1,18 @@
+package tfresource
+
+import (
+	"context"
+	"time"
+)
+
+func RetryWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func(context.Context) (T, error), codes ...string) (T, error) {
+	return f(ctx)
+}
+
+func RetryWhenNotFound[T any](ctx context.Context, timeout time.Duration, f func(context.Context) (T, error)) (T, error) {
+	return f(ctx)
+}
+
+func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func(context.Context) (any, error)) (any, error) {
+	return f(ctx)
+}
@@ -0,0 +1,118 @@
+package AWSR006
+
+import (
+	"go/ast"
+	"go/constant"
+	"reflect"
+	"strconv"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/annotation"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/tags"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for tags and tags_all attribute handling
+
+The AWSR006 analyzer reports when:
+
+- a data source (a file containing only @SDKDataSource or @FrameworkDataSource
+  annotated functions) declares a tags_all attribute, which is only meaningful
+  for resources
+- a Terraform Plugin Framework model struct field with a tfsdk:"tags" or
+  tfsdk:"tags_all" struct tag is not of type tftags.Map
+`
+
+const analyzerName = "AWSR006"
+
+const attributeNameTagsAll = "tags_all"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, file := range pass.Files {
+		isDataSourceFile := isDataSourceOnlyFile(file)
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.KeyValueExpr:
+				if !isDataSourceFile || !isTagsAllKey(pass, n.Key) {
+					return true
+				}
+
+				if commentIgnorer.ShouldIgnore(analyzerName, n) {
+					return true
+				}
+
+				pass.Reportf(n.Key.Pos(), "%s: data sources should not have a tags_all attribute", analyzerName)
+			case *ast.Field:
+				if n.Tag == nil {
+					return true
+				}
+
+				tag, err := strconv.Unquote(n.Tag.Value)
+
+				if err != nil {
+					return true
+				}
+
+				name := reflect.StructTag(tag).Get("tfsdk")
+
+				if name != "tags" && name != attributeNameTagsAll {
+					return true
+				}
+
+				if commentIgnorer.ShouldIgnore(analyzerName, n) {
+					return true
+				}
+
+				if isDataSourceFile && name == attributeNameTagsAll {
+					pass.Reportf(n.Tag.Pos(), "%s: data sources should not have a tags_all attribute", analyzerName)
+				}
+
+				if !tags.IsTypeMap(pass.TypesInfo.TypeOf(n.Type)) {
+					pass.Reportf(n.Type.Pos(), "%s: use type tftags.Map for %s", analyzerName, name)
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isDataSourceOnlyFile returns if the file contains data source annotated functions and no resource annotated functions.
+func isDataSourceOnlyFile(file *ast.File) bool {
+	var dataSource, resource bool
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+
+		if !ok {
+			continue
+		}
+
+		annotations := annotation.FuncDecl(funcDecl)
+		dataSource = dataSource || annotation.IsDataSource(annotations)
+		resource = resource || annotation.IsResource(annotations)
+	}
+
+	return dataSource && !resource
+}
+
+// isTagsAllKey returns if the expression is the constant "tags_all", e.g. names.AttrTagsAll.
+func isTagsAllKey(pass *analysis.Pass, e ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[e]
+
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.String && constant.StringVal(tv.Value) == attributeNameTagsAll
+}
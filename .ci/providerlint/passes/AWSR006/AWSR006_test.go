@@ -0,0 +1,12 @@
+package AWSR006
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR006(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/hashicorp/terraform-provider-aws/internal/service/a")
+}
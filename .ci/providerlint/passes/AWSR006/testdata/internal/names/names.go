@@ -0,0 +1,6 @@
+package names
+
+const (
+	AttrTags    = "tags"
+	AttrTagsAll = "tags_all"
+)
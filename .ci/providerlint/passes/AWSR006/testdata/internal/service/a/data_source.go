@@ -0,0 +1,36 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/names"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_a_thing", name="Thing")
+// @Tags
+func dataSourceThing() map[string]*tftags.Schema {
+	return map[string]*tftags.Schema{
+		/* Passing cases */
+
+		names.AttrTags: tftags.TagsSchemaComputed(),
+
+		/* Failing cases */
+
+		names.AttrTagsAll: tftags.TagsSchemaComputed(), // want "data sources should not have a tags_all attribute"
+	}
+}
+
+// @SDKDataSource("aws_a_other_thing", name="Other Thing")
+// @Tags
+func dataSourceOtherThing() map[string]*tftags.Schema {
+	return map[string]*tftags.Schema{
+		/* Comment ignored cases */
+
+		//lintignore:AWSR006
+		"tags_all": tftags.TagsSchemaComputed(),
+	}
+}
+
+type thingDataSourceModel struct {
+	Tags    tftags.Map `tfsdk:"tags"`
+	TagsAll tftags.Map `tfsdk:"tags_all"` // want "data sources should not have a tags_all attribute"
+}
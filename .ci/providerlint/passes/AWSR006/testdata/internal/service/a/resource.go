@@ -0,0 +1,37 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/names"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKResource("aws_a_thing", name="Thing")
+// @Tags(identifierAttribute="arn")
+func resourceThing() map[string]*tftags.Schema {
+	/* Passing cases */
+
+	return map[string]*tftags.Schema{
+		names.AttrTags:    tftags.TagsSchemaComputed(),
+		names.AttrTagsAll: tftags.TagsSchemaComputed(),
+	}
+}
+
+type thingResourceModel struct {
+	Name    string     `tfsdk:"name"`
+	Tags    tftags.Map `tfsdk:"tags"`
+	TagsAll tftags.Map `tfsdk:"tags_all"`
+}
+
+/* Comment ignored cases */
+
+type ignoredResourceModel struct {
+	//lintignore:AWSR006
+	Tags map[string]string `tfsdk:"tags"`
+}
+
+/* Failing cases */
+
+type otherResourceModel struct {
+	Tags    map[string]string `tfsdk:"tags"`     // want "use type tftags.Map for tags"
+	TagsAll map[string]string `tfsdk:"tags_all"` // want "use type tftags.Map for tags_all"
+}
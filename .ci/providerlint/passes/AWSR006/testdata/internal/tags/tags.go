@@ -0,0 +1,11 @@
+package tags
+
+type Map map[string]string
+
+type Schema struct {
+	Computed bool
+}
+
+func TagsSchemaComputed() *Schema {
+	return &Schema{Computed: true}
+}
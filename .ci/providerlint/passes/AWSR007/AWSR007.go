@@ -0,0 +1,118 @@
+package AWSR007
+
+import (
+	"go/ast"
+	"go/constant"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/annotation"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/helper/awsprovidertype/tags"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources with a tags attribute but without @Tags annotation
+
+The AWSR007 analyzer reports when a file declares a configurable tags attribute,
+e.g. names.AttrTags: tftags.TagsSchema() or names.AttrTags: tftags.TagsAttribute(),
+and a tags_all attribute, but the @SDKResource or @FrameworkResource annotated function in the file is
+missing the @Tags annotation. Without the annotation, transparent tagging is
+not enabled for the resource.
+`
+
+const analyzerName = "AWSR007"
+
+const (
+	attributeNameTags    = "tags"
+	attributeNameTagsAll = "tags_all"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, file := range pass.Files {
+		if !hasTagsAttribute(pass, file) {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok {
+				continue
+			}
+
+			annotations := annotation.FuncDecl(funcDecl)
+
+			if !annotation.IsResource(annotations) {
+				continue
+			}
+
+			if _, ok := annotations[annotation.NameTags]; ok {
+				continue
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, funcDecl) {
+				continue
+			}
+
+			pass.Reportf(funcDecl.Name.Pos(), "%s: resource with tags attribute is missing @Tags annotation", analyzerName)
+		}
+	}
+
+	return nil, nil
+}
+
+// hasTagsAttribute returns if the file declares a configurable tags attribute and a tags_all attribute.
+// Requiring tags_all excludes tags attributes of nested blocks, e.g. lifecycle rule filters.
+func hasTagsAttribute(pass *analysis.Pass, file *ast.File) bool {
+	var tagsFound, tagsAllFound bool
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+
+		if !ok {
+			return true
+		}
+
+		tv, ok := pass.TypesInfo.Types[kv.Key]
+
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return true
+		}
+
+		switch constant.StringVal(tv.Value) {
+		case attributeNameTags:
+			callExpr, ok := ast.Unparen(kv.Value).(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			for _, funcName := range []string{
+				tags.FuncNameTagsAttribute,
+				tags.FuncNameTagsAttributeRequired,
+				tags.FuncNameTagsSchema,
+				tags.FuncNameTagsSchemaForceNew,
+			} {
+				if tags.IsFunc(callExpr.Fun, pass.TypesInfo, funcName) {
+					tagsFound = true
+				}
+			}
+		case attributeNameTagsAll:
+			tagsAllFound = true
+		}
+
+		return true
+	})
+
+	return tagsFound && tagsAllFound
+}
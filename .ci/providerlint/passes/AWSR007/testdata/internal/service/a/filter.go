@@ -0,0 +1,16 @@
+package a
+
+import (
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+/* Passing cases */
+
+// @SDKResource("aws_a_filter", name="Filter")
+func resourceFilter() map[string]map[string]*tftags.Schema {
+	return map[string]map[string]*tftags.Schema{
+		"filter": {
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package a
+
+import (
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+/* Comment ignored cases */
+
+// lintignore:AWSR007
+// @SDKResource("aws_a_gadget", name="Gadget")
+func resourceGadget() map[string]*tftags.Schema {
+	return map[string]*tftags.Schema{
+		"tags":     tftags.TagsSchema(),
+		"tags_all": tftags.TagsSchemaComputed(),
+	}
+}
@@ -0,0 +1,17 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/names"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+/* Passing cases */
+
+// @SDKResource("aws_a_thing", name="Thing")
+// @Tags(identifierAttribute="arn")
+func resourceThing() map[string]*tftags.Schema {
+	return map[string]*tftags.Schema{
+		names.AttrTags:    tftags.TagsSchema(),
+		names.AttrTagsAll: tftags.TagsSchemaComputed(),
+	}
+}
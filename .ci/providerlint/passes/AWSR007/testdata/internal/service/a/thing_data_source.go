@@ -0,0 +1,15 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/names"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+/* Passing cases */
+
+// @SDKDataSource("aws_a_thing", name="Thing")
+func dataSourceThing() map[string]*tftags.Schema {
+	return map[string]*tftags.Schema{
+		names.AttrTags: tftags.TagsSchemaComputed(),
+	}
+}
@@ -0,0 +1,16 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/names"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+/* Failing cases */
+
+// @SDKResource("aws_a_widget", name="Widget")
+func resourceWidget() map[string]*tftags.Schema { // want "resource with tags attribute is missing @Tags annotation"
+	return map[string]*tftags.Schema{
+		names.AttrTags:    tftags.TagsSchema(),
+		names.AttrTagsAll: tftags.TagsSchemaComputed(),
+	}
+}
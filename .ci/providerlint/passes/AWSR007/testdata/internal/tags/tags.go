@@ -0,0 +1,13 @@
+package tags
+
+type Schema struct {
+	Computed bool
+}
+
+func TagsSchema() *Schema {
+	return &Schema{}
+}
+
+func TagsSchemaComputed() *Schema {
+	return &Schema{Computed: true}
+}
@@ -9,6 +9,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSAT006"
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR001"
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR002"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR003"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR004"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR005"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR006"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR007"
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSV001"
 	"golang.org/x/tools/go/analysis"
 )
@@ -22,5 +27,10 @@ var AllChecks = []*analysis.Analyzer{
 	AWSAT006.Analyzer,
 	AWSR001.Analyzer,
 	AWSR002.Analyzer,
+	AWSR003.Analyzer,
+	AWSR004.Analyzer,
+	AWSR005.Analyzer,
+	AWSR006.Analyzer,
+	AWSR007.Analyzer,
 	AWSV001.Analyzer,
 }
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+var _ plancheck.PlanCheck = expectNoForceNewCheck{}
+
+type expectNoForceNewCheck struct {
+	base Base
+}
+
+func (e expectNoForceNewCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if resource.Change == nil || !resource.Change.Actions.Replace() {
+		return
+	}
+
+	paths := make([]string, 0, len(resource.Change.ReplacePaths))
+	for _, path := range resource.Change.ReplacePaths {
+		paths = append(paths, formatReplacePath(path))
+	}
+
+	if len(paths) == 0 {
+		response.Error = fmt.Errorf("%s - expected no replacement, got %q action", resource.Address, resource.Change.Actions)
+
+		return
+	}
+
+	response.Error = fmt.Errorf("%s - expected no replacement, got %q action, attribute(s) requiring replacement: %s", resource.Address, resource.Change.Actions, strings.Join(paths, ", "))
+}
+
+// ExpectNoForceNew returns a plan check that asserts that the planned action for the resource is not a replacement.
+// If the resource is to be replaced, the attribute paths that require replacement are reported.
+func ExpectNoForceNew(resourceAddress string) plancheck.PlanCheck {
+	return expectNoForceNewCheck{
+		base: NewBase(resourceAddress),
+	}
+}
+
+// formatReplacePath formats a `replace_paths` element from a Terraform JSON plan,
+// e.g. `["ebs_block_device", 0, "volume_size"]` as `ebs_block_device.0.volume_size`.
+func formatReplacePath(path any) string {
+	steps, ok := path.([]any)
+	if !ok {
+		return fmt.Sprintf("%v", path)
+	}
+
+	var sb strings.Builder
+	for i, step := range steps {
+		if i > 0 {
+			sb.WriteString(".")
+		}
+		switch v := step.(type) {
+		case float64:
+			fmt.Fprintf(&sb, "%d", int64(v))
+		default:
+			fmt.Fprintf(&sb, "%v", v)
+		}
+	}
+
+	return sb.String()
+}
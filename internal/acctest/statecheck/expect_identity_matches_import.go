@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+var _ statecheck.StateCheck = &ExpectIdentityMatchesImportCheck{}
+
+// ExpectIdentityMatchesImportCheck records a resource's identity and attribute values after it has been created
+// and compares them with the values planned when the resource is imported by identity.
+//
+// The check itself is used in the `ConfigStateChecks` of the step creating the resource and the plan check
+// returned by `ImportPlanCheck` is used in the `ImportPlanChecks` of a later `ImportBlockWithResourceIdentity` step.
+type ExpectIdentityMatchesImportCheck struct {
+	base           Base
+	ignoredAttrs   []string
+	identityValues map[string]any
+	attrValues     map[string]any
+}
+
+func (e *ExpectIdentityMatchesImportCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if resource.IdentitySchemaVersion == nil || len(resource.IdentityValues) == 0 {
+		response.Error = fmt.Errorf("%s - Identity not found in state. Either the resource does not support identity or the Terraform version running the test does not support identity. (must be v1.12+)", e.base.ResourceAddress())
+
+		return
+	}
+
+	e.identityValues = maps.Clone(resource.IdentityValues)
+	e.attrValues = maps.Clone(resource.AttributeValues)
+}
+
+// ImportPlanCheck returns a plan check that compares the identity and attribute values planned by an import with the values recorded by the state check.
+// Attributes which are unknown in the plan or which were ignored when constructing the check are not compared.
+func (e *ExpectIdentityMatchesImportCheck) ImportPlanCheck() plancheck.PlanCheck {
+	return expectIdentityMatchesImportPlanCheck{
+		check: e,
+	}
+}
+
+// ExpectIdentityMatchesImport returns a check that compares the identity and attribute values of a resource after creation
+// with those produced by importing the resource by identity.
+// Attributes that cannot be read back from the remote API should be passed in `ignoredAttrs`,
+// either as top-level attribute names or as flatmap-style paths to nested values, e.g. `action.0.forward`.
+func ExpectIdentityMatchesImport(resourceAddress string, ignoredAttrs ...string) *ExpectIdentityMatchesImportCheck {
+	return &ExpectIdentityMatchesImportCheck{
+		base:         NewBase(resourceAddress),
+		ignoredAttrs: ignoredAttrs,
+	}
+}
+
+var _ plancheck.PlanCheck = expectIdentityMatchesImportPlanCheck{}
+
+type expectIdentityMatchesImportPlanCheck struct {
+	check *ExpectIdentityMatchesImportCheck
+}
+
+func (e expectIdentityMatchesImportPlanCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	address := e.check.base.ResourceAddress()
+
+	if e.check.identityValues == nil {
+		response.Error = fmt.Errorf("%s - No identity recorded. The state check must be run in a step before the import", address)
+
+		return
+	}
+
+	if request.Plan == nil {
+		response.Error = fmt.Errorf("plan is nil")
+
+		return
+	}
+
+	idx := slices.IndexFunc(request.Plan.ResourceChanges, func(r *tfjson.ResourceChange) bool {
+		return r.Address == address
+	})
+	if idx == -1 {
+		response.Error = fmt.Errorf("%s - Resource not found in plan", address)
+
+		return
+	}
+	resource := request.Plan.ResourceChanges[idx]
+
+	if resource.Change == nil || resource.Change.Importing == nil {
+		response.Error = fmt.Errorf("%s - Expected an import operation", address)
+
+		return
+	}
+
+	identityValues, _ := resource.Change.AfterIdentity.(map[string]any)
+	if diffs := diffValues(e.check.identityValues, identityValues, nil, nil); len(diffs) > 0 {
+		response.Error = fmt.Errorf("%s - Imported identity does not match identity after create: %s", address, strings.Join(diffs, ", "))
+
+		return
+	}
+
+	attrValues, _ := resource.Change.After.(map[string]any)
+	unknownValues, _ := resource.Change.AfterUnknown.(map[string]any)
+	if diffs := diffValues(e.check.attrValues, attrValues, unknownValues, e.check.ignoredAttrs); len(diffs) > 0 {
+		response.Error = fmt.Errorf("%s - Imported attributes do not match attributes after create: %s", address, strings.Join(diffs, ", "))
+
+		return
+	}
+}
+
+// diffValues returns descriptions of the values which differ between the expected and actual maps.
+// Nested values are compared element by element and reported using flatmap-style paths, e.g. `action.0.forward`.
+// An ignored path excludes the value at that path and all values nested beneath it.
+func diffValues(expected, actual, unknown map[string]any, ignored []string) []string {
+	var diffs []string
+
+	diffObjects(&diffs, "", expected, actual, unknown, ignored)
+
+	return diffs
+}
+
+func diffObjects(diffs *[]string, prefix string, expected, actual, unknown map[string]any, ignored []string) {
+	keys := slices.Sorted(maps.Keys(expected))
+	for _, key := range slices.Sorted(maps.Keys(actual)) {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		diffValue(diffs, joinPath(prefix, key), expected[key], actual[key], unknown[key], ignored)
+	}
+}
+
+func diffValue(diffs *[]string, path string, expected, actual, unknown any, ignored []string) {
+	if isIgnoredPath(path, ignored) || containsUnknown(unknown) {
+		return
+	}
+
+	switch expected := expected.(type) {
+	case map[string]any:
+		if actual, ok := actual.(map[string]any); ok {
+			unknown, _ := unknown.(map[string]any)
+			diffObjects(diffs, path, expected, actual, unknown, ignored)
+
+			return
+		}
+	case []any:
+		if actual, ok := actual.([]any); ok {
+			if len(expected) != len(actual) && !isIgnoredPath(joinPath(path, "#"), ignored) {
+				*diffs = append(*diffs, fmt.Sprintf("%q: expected %d elements, got %d", joinPath(path, "#"), len(expected), len(actual)))
+			}
+
+			unknown, _ := unknown.([]any)
+			for i := range min(len(expected), len(actual)) {
+				var u any
+				if i < len(unknown) {
+					u = unknown[i]
+				}
+				diffValue(diffs, joinPath(path, strconv.Itoa(i)), expected[i], actual[i], u, ignored)
+			}
+
+			return
+		}
+	}
+
+	if reflect.DeepEqual(expected, actual) {
+		return
+	}
+
+	if expected == nil {
+		*diffs = append(*diffs, fmt.Sprintf("%q: unexpected value %v", path, actual))
+	} else {
+		*diffs = append(*diffs, fmt.Sprintf("%q: expected %v, got %v", path, expected, actual))
+	}
+}
+
+// isIgnoredPath returns whether the flatmap-style path is, or is nested beneath, one of the ignored paths.
+func isIgnoredPath(path string, ignored []string) bool {
+	return slices.ContainsFunc(ignored, func(v string) bool {
+		return path == v || strings.HasPrefix(path, v+".")
+	})
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
+
+// containsUnknown returns whether a value from a plan's `after_unknown` object marks any (nested) value as unknown.
+func containsUnknown(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case []any:
+		return slices.ContainsFunc(v, containsUnknown)
+	case map[string]any:
+		for _, v := range v {
+			if containsUnknown(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// StubAWSResponse is the response returned by a stub AWS API endpoint.
+type StubAWSResponse struct {
+	StatusCode int
+	Header     map[string]string
+	Body       string
+}
+
+// StubAWSEndpoint is a local HTTP server standing in for an AWS API endpoint in unit tests.
+type StubAWSEndpoint struct {
+	// Config is an AWS SDK for Go v2 configuration that uses the stub as its base endpoint.
+	Config aws.Config
+
+	requests atomic.Int32
+}
+
+// Requests returns the number of requests made to the stub endpoint.
+func (e *StubAWSEndpoint) Requests() int {
+	return int(e.requests.Load())
+}
+
+// NewStubAWSEndpoint starts a stub AWS API endpoint that returns the specified response to requests for the specified operation.
+// The operation is matched against the X-Amz-Target header of AWS JSON protocol requests or the URL path of REST requests.
+// All other requests receive a 404 Not Found response.
+func NewStubAWSEndpoint(t *testing.T, operation string, response StubAWSResponse) *StubAWSEndpoint {
+	t.Helper()
+
+	e := &StubAWSEndpoint{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e.requests.Add(1)
+
+		if r.Header.Get("X-Amz-Target") != operation && r.URL.Path != operation {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		for k, v := range response.Header {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(response.StatusCode)
+		w.Write([]byte(response.Body)) //nolint:errcheck // Test stub
+	}))
+	t.Cleanup(server.Close)
+
+	e.Config = aws.Config{
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:       endpoints.UsWest2RegionID,
+	}
+
+	return e
+}
+
+// AWSClient returns provider state (AKA "meta" or "conns.AWSClient") for the stub endpoint's Region
+// with the specified validate_with_aws provider configuration value and API client for the specified service package.
+func (e *StubAWSEndpoint) AWSClient(validateWithAWS bool, servicePackageName string, apiClient any) *conns.AWSClient {
+	c := &conns.AWSClient{}
+	conns.SetAWSConfig(c, &e.Config)
+	conns.SetClient(c, e.Config.Region, servicePackageName, apiClient)
+	conns.SetValidateWithAWS(c, validateWithAWS)
+
+	return c
+}
@@ -122,11 +122,32 @@ var (
 	_ DelayWithSetIncrementDelay = (*sdkv2HelperRetryCompatibleDelay)(nil)
 )
 
+// Status represents the most recently observed status of the operation a loop is waiting on.
+type Status struct {
+	State           string // Current state, e.g. "modifying".
+	PercentComplete *int   // Percentage complete, if the API exposes it.
+	Reason          string // Reason for the current state, e.g. a status message or the last retryable error.
+}
+
+// Progress represents the progress of a loop.
+type Progress struct {
+	Status
+
+	Attempt   uint
+	Elapsed   time.Duration
+	Remaining time.Duration
+}
+
+// ProgressReporter is called periodically with the progress of a loop.
+type ProgressReporter func(context.Context, Progress)
+
 // LoopConfig configures a loop.
 type LoopConfig struct {
-	delay       Delay
-	gracePeriod time.Duration
-	timer       Timer
+	delay            Delay
+	gracePeriod      time.Duration
+	progressInterval time.Duration
+	progressReporter ProgressReporter
+	timer            Timer
 }
 
 // Option represents a loop option.
@@ -150,6 +171,18 @@ func WithDelay(d Delay) Option {
 	}
 }
 
+// WithProgressReporter reports the loop's progress no more often than the specified interval.
+func WithProgressReporter(r ProgressReporter, interval time.Duration) Option {
+	if r == nil {
+		return emptyOption
+	}
+
+	return func(c *LoopConfig) {
+		c.progressInterval = interval
+		c.progressReporter = r
+	}
+}
+
 // WithTimer provides a way to swap out timer module implementations.
 // This primarily is useful for mocking/testing, where you may not want to explicitly wait for a set duration
 // for retries.
@@ -177,10 +210,13 @@ func defaultLoopConfig() LoopConfig {
 
 // Loop holds state for managing loops with a timeout.
 type Loop struct {
-	attempt     uint
-	config      LoopConfig
-	deadline    inttypes.Deadline
-	gracePeriod time.Duration
+	attempt      uint
+	config       LoopConfig
+	deadline     inttypes.Deadline
+	gracePeriod  time.Duration
+	lastReported time.Time
+	started      time.Time
+	status       Status
 }
 
 // NewLoopWithOptions returns a new loop configured with the provided options.
@@ -190,10 +226,14 @@ func NewLoopWithOptions(timeout time.Duration, opts ...Option) *Loop {
 		opt(&config)
 	}
 
+	now := time.Now()
+
 	return &Loop{
-		config:      config,
-		deadline:    inttypes.NewDeadline(timeout),
-		gracePeriod: config.gracePeriod,
+		config:       config,
+		deadline:     inttypes.NewDeadline(timeout),
+		gracePeriod:  config.gracePeriod,
+		lastReported: now,
+		started:      now,
 	}
 }
 
@@ -215,6 +255,7 @@ func (r *Loop) Continue(ctx context.Context) bool {
 		r.gracePeriod = 0
 	}
 
+	r.reportProgress(ctx)
 	r.sleep(ctx, r.config.delay.Next(r.attempt))
 	r.attempt++
 
@@ -231,6 +272,32 @@ func (r *Loop) Remaining() time.Duration {
 	return r.deadline.Remaining()
 }
 
+// SetStatus sets the most recently observed status, which is included in subsequent progress reports.
+func (r *Loop) SetStatus(status Status) {
+	r.status = status
+}
+
+// reportProgress calls any progress reporter if the reporting interval has elapsed.
+// Progress is not reported before the first attempt.
+func (r *Loop) reportProgress(ctx context.Context) {
+	if r.config.progressReporter == nil || r.attempt == 0 {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(r.lastReported) < r.config.progressInterval {
+		return
+	}
+	r.lastReported = now
+
+	r.config.progressReporter(ctx, Progress{
+		Status:    r.status,
+		Attempt:   r.attempt,
+		Elapsed:   now.Sub(r.started),
+		Remaining: r.Remaining(),
+	})
+}
+
 // sleep sleeps for the specified duration or until the context is canceled, whichever occurs first.
 func (r *Loop) sleep(ctx context.Context, d time.Duration) {
 	if d == 0 {
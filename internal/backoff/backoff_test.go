@@ -5,10 +5,12 @@ package backoff
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestDefaultSDKv2HelperRetryCompatibleDelay(t *testing.T) {
@@ -140,3 +142,56 @@ func TestLoopWithTimeoutNoGracePeriod(t *testing.T) {
 		t.Errorf("Iterations = %v, want %v", got, want)
 	}
 }
+
+func TestLoopWithProgressReporter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var got []Progress
+	reporter := func(_ context.Context, progress Progress) {
+		got = append(got, progress)
+	}
+
+	var n int
+	for r := NewLoopWithOptions(1*time.Minute, WithDelay(ZeroDelay), WithProgressReporter(reporter, 0)); r.Continue(ctx); {
+		n++
+		r.SetStatus(Status{State: "pending", Reason: fmt.Sprintf("attempt %d", n)})
+
+		if n == 3 {
+			break
+		}
+	}
+
+	want := []Progress{
+		{Status: Status{State: "pending", Reason: "attempt 1"}, Attempt: 1},
+		{Status: Status{State: "pending", Reason: "attempt 2"}, Attempt: 2},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(Progress{}, "Elapsed", "Remaining")); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestLoopWithProgressReporterInterval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var got int
+	reporter := func(context.Context, Progress) {
+		got++
+	}
+
+	var n int
+	for r := NewLoopWithOptions(1*time.Minute, WithDelay(ZeroDelay), WithProgressReporter(reporter, 1*time.Hour)); r.Continue(ctx); {
+		n++
+
+		if n == 5 {
+			break
+		}
+	}
+
+	if want := 0; got != want {
+		t.Errorf("Reports = %v, want %v", got, want)
+	}
+}
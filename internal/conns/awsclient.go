@@ -43,6 +43,7 @@ type AWSClient struct {
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
 	terraformVersion          string // From provider configuration.
+	validateWithAWS           bool   // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -196,6 +197,11 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// ValidateWithAWS returns the validate_with_aws provider configuration value.
+func (c *AWSClient) ValidateWithAWS(context.Context) bool {
+	return c.validateWithAWS
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
 	c.httpClient = httpClient
@@ -61,6 +61,7 @@ type Config struct {
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	ValidateWithAWS                bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -204,6 +205,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.validateWithAWS = c.ValidateWithAWS
 
 	return client, diags
 }
@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -16,3 +17,27 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetAWSConfig is only intended for use in tests
+func SetAWSConfig(client *AWSClient, cfg *aws.Config) {
+	client.awsConfig = cfg
+}
+
+// SetClient is only intended for use in tests
+func SetClient(client *AWSClient, region, servicePackageName string, apiClient any) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if client.clients == nil {
+		client.clients = make(map[string]map[string]any)
+	}
+	if client.clients[region] == nil {
+		client.clients[region] = make(map[string]any)
+	}
+	client.clients[region][servicePackageName] = apiClient
+}
+
+// SetValidateWithAWS is only intended for use in tests
+func SetValidateWithAWS(client *AWSClient, v bool) {
+	client.validateWithAWS = v
+}
@@ -155,6 +155,11 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	if fieldOpts.converter != "" {
+		diags.Append(expandConverted(ctx, fieldOpts.converter, vFrom, vTo)...)
+		return diags
+	}
+
 	switch vFrom := vFrom.(type) {
 	// Primitive types.
 	case basetypes.BoolValuable:
@@ -1152,6 +1157,11 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if members, ok := unionMembers(valTo.Type()); ok {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1166,7 +1176,17 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		fromFieldName := fromField.Name
 		_, fromFieldOpts := autoflexTags(fromField)
 
-		toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		var toField reflect.StructField
+		var ok bool
+		if name := fromFieldOpts.Name(); name != "" {
+			tflog.SubsystemTrace(ctx, subsystemName, "Using field name override", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+				logAttrKeyTargetFieldname: name,
+			})
+			toField, ok = typeTo.FieldByName(name)
+		} else {
+			toField, ok = findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		}
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -1191,7 +1211,8 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		})
 
 		opts := fieldOpts{
-			legacy: fromFieldOpts.Legacy(),
+			legacy:    fromFieldOpts.Legacy(),
+			converter: fromFieldOpts.Converter(),
 		}
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
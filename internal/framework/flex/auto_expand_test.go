@@ -4484,7 +4484,7 @@ func TestExpandOptions(t *testing.T) {
 
 	type tf01 struct {
 		Field1 types.Bool                       `tfsdk:"field1"`
-		Tags   fwtypes.MapValueOf[types.String] `tfsdk:"tags"`
+		Tags   fwtypes.MapValueOf[types.String] `tfsdk:"tags"` //lintignore:AWSR006
 	}
 	type aws01 struct {
 		Field1 bool
@@ -5922,6 +5922,318 @@ type autoFlexTestCase struct {
 
 type autoFlexTestCases map[string]autoFlexTestCase
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"string member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "StringValue", "Field1", reflect.TypeFor[*awsUnionMemberStringValue]()),
+				infoConvertingWithPath("Field1[0].StringValue", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"string member with empty object member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "StringValue", "Field1", reflect.TypeFor[*awsUnionMemberStringValue]()),
+				infoConvertingWithPath("Field1[0].StringValue", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"object member": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "ObjectValue", "Field1", reflect.TypeFor[*awsUnionMemberObjectValue]()),
+				infoConvertingWithPath("Field1[0].ObjectValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].ObjectValue[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].ObjectValue[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"list of members": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberObjectValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "StringValue", "Field1[0]", reflect.TypeFor[*awsUnionMemberStringValue]()),
+				infoConvertingWithPath("Field1[0].StringValue", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoTargetIsUnion("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[1]", reflect.TypeFor[tfUnion](), "ObjectValue", "Field1[1]", reflect.TypeFor[*awsUnionMemberObjectValue]()),
+				infoConvertingWithPath("Field1[1].ObjectValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[1].Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[1].ObjectValue[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[1].Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[1].ObjectValue[0].Field1", reflect.TypeFor[types.String](), "Field1[1].Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"no member set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionMemberCount([]string{"string_value", "object_value"}, 0),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				errorExpectedExactlyOneUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion](), 0),
+			},
+		},
+		"multiple members set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionMemberCount([]string{"string_value", "object_value"}, 2),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				errorExpectedExactlyOneUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion](), 2),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandFieldNameOverride(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"name override": {
+			Source: tfFieldNameOverride{
+				VPCConfig: types.StringValue("value1"),
+			},
+			Target: &awsFieldNameOverride{},
+			WantTarget: &awsFieldNameOverride{
+				VpcConfiguration: aws.String("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfFieldNameOverride](), reflect.TypeFor[*awsFieldNameOverride]()),
+				infoConverting(reflect.TypeFor[tfFieldNameOverride](), reflect.TypeFor[*awsFieldNameOverride]()),
+				traceUsingFieldNameOverride("VPCConfig", reflect.TypeFor[tfFieldNameOverride](), "VpcConfiguration", reflect.TypeFor[*awsFieldNameOverride]()),
+				traceMatchedFields("VPCConfig", reflect.TypeFor[tfFieldNameOverride](), "VpcConfiguration", reflect.TypeFor[*awsFieldNameOverride]()),
+				infoConvertingWithPath("VPCConfig", reflect.TypeFor[types.String](), "VpcConfiguration", reflect.TypeFor[*string]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandConverter(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"converters": {
+			Source: tfConverter{
+				Timeout:   timetypes.NewGoDurationValueFromStringMust("1h30m"),
+				Mode:      types.StringValue("enabled"),
+				CreatedAt: timetypes.NewRFC3339ValueMust("2025-01-02T03:04:05Z"),
+				Data:      types.StringValue("aGVsbG8="),
+			},
+			Target: &awsConverter{},
+			WantTarget: &awsConverter{
+				Timeout:   aws.Int32(5400),
+				Mode:      "ENABLED",
+				CreatedAt: 1735787045,
+				Payload:   []byte("hello"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfConverter](), reflect.TypeFor[*awsConverter]()),
+				infoConverting(reflect.TypeFor[tfConverter](), reflect.TypeFor[*awsConverter]()),
+				traceMatchedFields("Timeout", reflect.TypeFor[tfConverter](), "Timeout", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32]()),
+				infoUsingConverter("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32](), "duration_seconds"),
+				traceMatchedFields("Mode", reflect.TypeFor[tfConverter](), "Mode", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Mode", reflect.TypeFor[types.String](), "Mode", reflect.TypeFor[string]()),
+				infoUsingConverter("Mode", reflect.TypeFor[types.String](), "Mode", reflect.TypeFor[string](), "uppercase"),
+				traceMatchedFields("CreatedAt", reflect.TypeFor[tfConverter](), "CreatedAt", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[int64]()),
+				infoUsingConverter("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[int64](), "epoch_seconds"),
+				traceUsingFieldNameOverride("Data", reflect.TypeFor[tfConverter](), "Payload", reflect.TypeFor[*awsConverter]()),
+				traceMatchedFields("Data", reflect.TypeFor[tfConverter](), "Payload", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Data", reflect.TypeFor[types.String](), "Payload", reflect.TypeFor[[]byte]()),
+				infoUsingConverter("Data", reflect.TypeFor[types.String](), "Payload", reflect.TypeFor[[]byte](), "base64"),
+			},
+		},
+		"null values": {
+			Source: tfConverter{
+				Timeout:   timetypes.NewGoDurationNull(),
+				Mode:      types.StringNull(),
+				CreatedAt: timetypes.NewRFC3339Null(),
+				Data:      types.StringNull(),
+			},
+			Target:     &awsConverter{},
+			WantTarget: &awsConverter{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfConverter](), reflect.TypeFor[*awsConverter]()),
+				infoConverting(reflect.TypeFor[tfConverter](), reflect.TypeFor[*awsConverter]()),
+				traceMatchedFields("Timeout", reflect.TypeFor[tfConverter](), "Timeout", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32]()),
+				traceExpandingNullValue("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32]()),
+				traceMatchedFields("Mode", reflect.TypeFor[tfConverter](), "Mode", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Mode", reflect.TypeFor[types.String](), "Mode", reflect.TypeFor[string]()),
+				traceExpandingNullValue("Mode", reflect.TypeFor[types.String](), "Mode", reflect.TypeFor[string]()),
+				traceMatchedFields("CreatedAt", reflect.TypeFor[tfConverter](), "CreatedAt", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[int64]()),
+				traceExpandingNullValue("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[int64]()),
+				traceUsingFieldNameOverride("Data", reflect.TypeFor[tfConverter](), "Payload", reflect.TypeFor[*awsConverter]()),
+				traceMatchedFields("Data", reflect.TypeFor[tfConverter](), "Payload", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Data", reflect.TypeFor[types.String](), "Payload", reflect.TypeFor[[]byte]()),
+				traceExpandingNullValue("Data", reflect.TypeFor[types.String](), "Payload", reflect.TypeFor[[]byte]()),
+			},
+		},
+		"duration overflows target": {
+			Source: tfConverterDuration{
+				Timeout: timetypes.NewGoDurationValueFromStringMust("1000000h"),
+			},
+			Target: &awsConverterInt32{},
+			expectedDiags: diag.Diagnostics{
+				diagConvertedValueOverflows(reflect.ValueOf(int64(3600000000)), reflect.TypeFor[int32]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfConverterDuration](), reflect.TypeFor[*awsConverterInt32]()),
+				infoConverting(reflect.TypeFor[tfConverterDuration](), reflect.TypeFor[*awsConverterInt32]()),
+				traceMatchedFields("Timeout", reflect.TypeFor[tfConverterDuration](), "Timeout", reflect.TypeFor[*awsConverterInt32]()),
+				infoConvertingWithPath("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32]()),
+				infoUsingConverter("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32](), "duration_seconds"),
+				errorConvertedValueOverflows("Timeout", reflect.TypeFor[timetypes.GoDuration](), "Timeout", reflect.TypeFor[*int32](), "duration_seconds", reflect.TypeFor[int64]()),
+			},
+		},
+		"integer to string": {
+			Source: tfConverterEpoch{
+				CreatedAt: timetypes.NewRFC3339ValueMust("2025-01-02T03:04:05Z"),
+			},
+			Target: &awsConverterString{},
+			expectedDiags: diag.Diagnostics{
+				diagCannotBeAssigned(reflect.TypeFor[int64](), reflect.TypeFor[*string]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfConverterEpoch](), reflect.TypeFor[*awsConverterString]()),
+				infoConverting(reflect.TypeFor[tfConverterEpoch](), reflect.TypeFor[*awsConverterString]()),
+				traceMatchedFields("CreatedAt", reflect.TypeFor[tfConverterEpoch](), "CreatedAt", reflect.TypeFor[*awsConverterString]()),
+				infoConvertingWithPath("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[*string]()),
+				infoUsingConverter("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[*string](), "epoch_seconds"),
+				errorConvertedValueCannotBeAssigned("CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "CreatedAt", reflect.TypeFor[*string](), "epoch_seconds", reflect.TypeFor[int64]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func runAutoExpandTestCases(t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -149,6 +149,11 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 
 	// main control flow
 	tTo := valTo.Type(ctx)
+
+	if fieldOpts.converter != "" {
+		diags.Append(flattenConverted(ctx, fieldOpts.converter, vFrom, tTo, vTo)...)
+		return diags
+	}
 	switch k := vFrom.Kind(); k {
 	case reflect.Bool:
 		diags.Append(flattener.bool(ctx, vFrom, false, tTo, vTo, fieldOpts)...)
@@ -192,7 +197,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -611,7 +616,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -655,7 +660,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1217,7 +1222,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1237,6 +1242,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	if _, ok := to.(Flattener); !ok && !vFrom.IsNil() {
+		if _, ok := unionMemberOf(vFrom.Elem().Type()); ok {
+			diags.Append(flattenStruct(ctx, sourcePath, vFrom.Elem().Interface(), targetPath, to, flattener)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Set the target structure as a mapped Object.
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
@@ -1508,13 +1532,27 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	if member, ok := unionMemberOf(valFrom.Type()); ok {
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, reflect.ValueOf(to), member, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
+	nameOverrides := fieldNameOverrides(typeTo)
 
 	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 
-		toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		toField, ok := nameOverrides[fromFieldName]
+		if ok {
+			tflog.SubsystemTrace(ctx, subsystemName, "Using field name override", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+				logAttrKeyTargetFieldname: toField.Name,
+			})
+		} else {
+			toField, ok = findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+		}
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
@@ -1524,6 +1562,13 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		}
 		toFieldName := toField.Name
 		toNameOverride, toOpts := autoflexTags(toField)
+		if name := toOpts.Name(); name != "" && name != fromFieldName {
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping target field with name override", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+			continue
+		}
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if toNameOverride == "-" {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored target field", map[string]any{
@@ -1556,6 +1601,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		opts := fieldOpts{
 			legacy:    toOpts.Legacy(),
 			omitempty: toOpts.OmitEmpty(),
+			converter: toOpts.Converter(),
 		}
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
@@ -1567,6 +1613,17 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 	return diags
 }
 
+// fieldNameOverrides returns the fields of the Plugin Framework struct type `typ` with a name override, keyed by the overriding name.
+func fieldNameOverrides(typ reflect.Type) map[string]reflect.StructField {
+	overrides := make(map[string]reflect.StructField)
+	for field := range tfreflect.ExportedStructFields(typ) {
+		if _, opts := autoflexTags(field); opts.Name() != "" {
+			overrides[opts.Name()] = field
+		}
+	}
+	return overrides
+}
+
 func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
@@ -4190,7 +4190,7 @@ func TestFlattenOptions(t *testing.T) {
 
 	type tf01 struct {
 		Field1 types.Bool                       `tfsdk:"field1"`
-		Tags   fwtypes.MapValueOf[types.String] `tfsdk:"tags"`
+		Tags   fwtypes.MapValueOf[types.String] `tfsdk:"tags"` //lintignore:AWSR006
 	}
 	type aws01 struct {
 		Field1 bool
@@ -5357,6 +5357,287 @@ func TestFlattenEmbeddedStruct(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases, cmpopts.EquateComparable(tfUnexportedEmbeddedStruct{}))
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nil union": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"string member": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberStringValue](), "Field1", reflect.TypeFor[*tfUnion](), reflect.TypeFor[awsUnion](), "StringValue"),
+				traceMatchedUnionMemberField("Field1", reflect.TypeFor[*awsUnionMemberStringValue](), "Field1", reflect.TypeFor[*tfUnion](), "StringValue"),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.StringValue", reflect.TypeFor[types.String]()),
+			},
+		},
+		"object member": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberObjectValue](), "Field1", reflect.TypeFor[*tfUnion](), reflect.TypeFor[awsUnion](), "ObjectValue"),
+				traceMatchedUnionMemberField("Field1", reflect.TypeFor[*awsUnionMemberObjectValue](), "Field1", reflect.TypeFor[*tfUnion](), "ObjectValue"),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.ObjectValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.ObjectValue", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.ObjectValue.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"list of members": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberObjectValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1[0]", reflect.TypeFor[awsUnionMemberStringValue](), "Field1[0]", reflect.TypeFor[*tfUnion](), reflect.TypeFor[awsUnion](), "StringValue"),
+				traceMatchedUnionMemberField("Field1[0]", reflect.TypeFor[*awsUnionMemberStringValue](), "Field1[0]", reflect.TypeFor[*tfUnion](), "StringValue"),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].StringValue", reflect.TypeFor[types.String]()),
+				infoSourceIsUnionMember("Field1[1]", reflect.TypeFor[awsUnionMemberObjectValue](), "Field1[1]", reflect.TypeFor[*tfUnion](), reflect.TypeFor[awsUnion](), "ObjectValue"),
+				traceMatchedUnionMemberField("Field1[1]", reflect.TypeFor[*awsUnionMemberObjectValue](), "Field1[1]", reflect.TypeFor[*tfUnion](), "ObjectValue"),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[awsSingleStringValue](), "Field1[1].ObjectValue", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[1].Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[1].ObjectValue", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[1].Value.Field1", reflect.TypeFor[string](), "Field1[1].ObjectValue.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestUnionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]awsUnionSlice{
+		"string member": {
+			Field1: []awsUnion{
+				&awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+		},
+		"object member": {
+			Field1: []awsUnion{
+				&awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+		},
+		"mixed members": {
+			Field1: []awsUnion{
+				&awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+				&awsUnionMemberStringValue{
+					Value: "value2",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var tf tfListNestedObject[tfUnion]
+			if diags := Flatten(ctx, testCase, &tf); diags.HasError() {
+				t.Fatalf("Flatten: %v", diags)
+			}
+
+			var got awsUnionSlice
+			if diags := Expand(ctx, tf, &got); diags.HasError() {
+				t.Fatalf("Expand: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenFieldNameOverride(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"name override": {
+			Source: awsFieldNameOverride{
+				VpcConfig:        aws.String("value1"),
+				VpcConfiguration: aws.String("value2"),
+			},
+			Target: &tfFieldNameOverride{},
+			WantTarget: &tfFieldNameOverride{
+				VPCConfig: types.StringValue("value2"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsFieldNameOverride](), reflect.TypeFor[*tfFieldNameOverride]()),
+				infoConverting(reflect.TypeFor[awsFieldNameOverride](), reflect.TypeFor[*tfFieldNameOverride]()),
+				traceSkipTargetFieldWithNameOverride("VpcConfig", reflect.TypeFor[awsFieldNameOverride](), "VPCConfig", reflect.TypeFor[*tfFieldNameOverride]()),
+				traceUsingFieldNameOverride("VpcConfiguration", reflect.TypeFor[awsFieldNameOverride](), "VPCConfig", reflect.TypeFor[*tfFieldNameOverride]()),
+				traceMatchedFields("VpcConfiguration", reflect.TypeFor[awsFieldNameOverride](), "VPCConfig", reflect.TypeFor[*tfFieldNameOverride]()),
+				infoConvertingWithPath("VpcConfiguration", reflect.TypeFor[*string](), "VPCConfig", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenConverter(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"converters": {
+			Source: awsConverter{
+				Timeout:   aws.Int32(5400),
+				Mode:      "ENABLED",
+				CreatedAt: 1735787045,
+				Payload:   []byte("hello"),
+			},
+			Target: &tfConverter{},
+			WantTarget: &tfConverter{
+				Timeout:   timetypes.NewGoDurationValueFromStringMust("1h30m0s"),
+				Mode:      types.StringValue("enabled"),
+				CreatedAt: timetypes.NewRFC3339ValueMust("2025-01-02T03:04:05Z"),
+				Data:      types.StringValue("aGVsbG8="),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsConverter](), reflect.TypeFor[*tfConverter]()),
+				infoConverting(reflect.TypeFor[awsConverter](), reflect.TypeFor[*tfConverter]()),
+				traceMatchedFields("Timeout", reflect.TypeFor[awsConverter](), "Timeout", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Timeout", reflect.TypeFor[*int32](), "Timeout", reflect.TypeFor[timetypes.GoDuration]()),
+				infoUsingConverter("Timeout", reflect.TypeFor[*int32](), "Timeout", reflect.TypeFor[timetypes.GoDuration](), "duration_seconds"),
+				traceMatchedFields("Mode", reflect.TypeFor[awsConverter](), "Mode", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Mode", reflect.TypeFor[string](), "Mode", reflect.TypeFor[types.String]()),
+				infoUsingConverter("Mode", reflect.TypeFor[string](), "Mode", reflect.TypeFor[types.String](), "uppercase"),
+				traceMatchedFields("CreatedAt", reflect.TypeFor[awsConverter](), "CreatedAt", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("CreatedAt", reflect.TypeFor[int64](), "CreatedAt", reflect.TypeFor[timetypes.RFC3339]()),
+				infoUsingConverter("CreatedAt", reflect.TypeFor[int64](), "CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "epoch_seconds"),
+				traceUsingFieldNameOverride("Payload", reflect.TypeFor[awsConverter](), "Data", reflect.TypeFor[*tfConverter]()),
+				traceMatchedFields("Payload", reflect.TypeFor[awsConverter](), "Data", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Payload", reflect.TypeFor[[]byte](), "Data", reflect.TypeFor[types.String]()),
+				infoUsingConverter("Payload", reflect.TypeFor[[]byte](), "Data", reflect.TypeFor[types.String](), "base64"),
+			},
+		},
+		"nil values": {
+			Source: awsConverter{},
+			Target: &tfConverter{},
+			WantTarget: &tfConverter{
+				Timeout:   timetypes.NewGoDurationNull(),
+				Mode:      types.StringValue(""),
+				CreatedAt: timetypes.NewRFC3339ValueMust("1970-01-01T00:00:00Z"),
+				Data:      types.StringNull(),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsConverter](), reflect.TypeFor[*tfConverter]()),
+				infoConverting(reflect.TypeFor[awsConverter](), reflect.TypeFor[*tfConverter]()),
+				traceMatchedFields("Timeout", reflect.TypeFor[awsConverter](), "Timeout", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Timeout", reflect.TypeFor[*int32](), "Timeout", reflect.TypeFor[timetypes.GoDuration]()),
+				infoUsingConverter("Timeout", reflect.TypeFor[*int32](), "Timeout", reflect.TypeFor[timetypes.GoDuration](), "duration_seconds"),
+				traceFlatteningNullValueWithConverter("Timeout", reflect.TypeFor[*int32](), "Timeout", reflect.TypeFor[timetypes.GoDuration](), "duration_seconds"),
+				traceMatchedFields("Mode", reflect.TypeFor[awsConverter](), "Mode", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Mode", reflect.TypeFor[string](), "Mode", reflect.TypeFor[types.String]()),
+				infoUsingConverter("Mode", reflect.TypeFor[string](), "Mode", reflect.TypeFor[types.String](), "uppercase"),
+				traceMatchedFields("CreatedAt", reflect.TypeFor[awsConverter](), "CreatedAt", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("CreatedAt", reflect.TypeFor[int64](), "CreatedAt", reflect.TypeFor[timetypes.RFC3339]()),
+				infoUsingConverter("CreatedAt", reflect.TypeFor[int64](), "CreatedAt", reflect.TypeFor[timetypes.RFC3339](), "epoch_seconds"),
+				traceUsingFieldNameOverride("Payload", reflect.TypeFor[awsConverter](), "Data", reflect.TypeFor[*tfConverter]()),
+				traceMatchedFields("Payload", reflect.TypeFor[awsConverter](), "Data", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Payload", reflect.TypeFor[[]byte](), "Data", reflect.TypeFor[types.String]()),
+				infoUsingConverter("Payload", reflect.TypeFor[[]byte](), "Data", reflect.TypeFor[types.String](), "base64"),
+				traceFlatteningNullValueWithConverter("Payload", reflect.TypeFor[[]byte](), "Data", reflect.TypeFor[types.String](), "base64"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases, opts ...cmp.Option) {
 	t.Helper()
 
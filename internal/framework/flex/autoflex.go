@@ -156,6 +156,7 @@ func autoflexTags(field reflect.StructField) (string, tagOptions) {
 type fieldOpts struct {
 	legacy    bool
 	omitempty bool
+	converter string
 }
 
 // valueWithElementsAs extends the Value interface for values that have an ElementsAs method.
@@ -558,6 +558,82 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+// Smithy union.
+type awsUnion interface {
+	isAwsUnion()
+}
+
+type awsUnionMemberStringValue struct {
+	Value string
+}
+
+func (*awsUnionMemberStringValue) isAwsUnion() {}
+
+type awsUnionMemberObjectValue struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObjectValue) isAwsUnion() {}
+
+func init() {
+	RegisterUnion[awsUnion](
+		&awsUnionMemberStringValue{},
+		&awsUnionMemberObjectValue{},
+	)
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type tfUnion struct {
+	StringValue types.String                                         `tfsdk:"string_value"`
+	ObjectValue fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object_value"`
+}
+
+type tfFieldNameOverride struct {
+	VPCConfig types.String `tfsdk:"vpc_config" autoflex:"name=VpcConfiguration"`
+}
+
+type awsFieldNameOverride struct {
+	VpcConfig        *string
+	VpcConfiguration *string
+}
+
+type tfConverter struct {
+	Timeout   timetypes.GoDuration `tfsdk:"timeout" autoflex:"convert=duration_seconds"`
+	Mode      types.String         `tfsdk:"mode" autoflex:"convert=uppercase"`
+	CreatedAt timetypes.RFC3339    `tfsdk:"created_at" autoflex:"convert=epoch_seconds"`
+	Data      types.String         `tfsdk:"data" autoflex:"name=Payload,convert=base64"`
+}
+
+type awsConverter struct {
+	Timeout   *int32
+	Mode      string
+	CreatedAt int64
+	Payload   []byte
+}
+
+type tfConverterDuration struct {
+	Timeout timetypes.GoDuration `tfsdk:"timeout" autoflex:"convert=duration_seconds"`
+}
+
+type awsConverterInt32 struct {
+	Timeout *int32
+}
+
+type tfConverterEpoch struct {
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at" autoflex:"convert=epoch_seconds"`
+}
+
+type awsConverterString struct {
+	CreatedAt *string
+}
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
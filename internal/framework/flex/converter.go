@@ -0,0 +1,519 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// Converter converts a field's value between its Terraform and AWS representations,
+// where AutoFlex's default conversion does not apply, e.g. for a duration in seconds.
+// A converter is referenced by name from the Terraform model, e.g. `autoflex:"convert=duration_seconds"`.
+//
+// Null and unknown values are handled by AutoFlex and are not passed to a converter.
+type Converter interface {
+	// Expand converts a Terraform value to a value assignable or convertible to the AWS type.
+	// A pointer to the returned value is assigned to an AWS pointer type.
+	Expand(ctx context.Context, v attr.Value, targetType reflect.Type) (any, diag.Diagnostics)
+	// Flatten converts an AWS value, dereferenced if it is a pointer, to a Terraform value.
+	// The returned value is converted to the Terraform type, e.g. `types.String` to `timetypes.RFC3339`.
+	Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics)
+}
+
+type converterRegistry struct {
+	mu         sync.RWMutex
+	converters map[string]Converter
+}
+
+var converters = converterRegistry{
+	converters: map[string]Converter{
+		"base64":             base64Converter{},
+		"duration_minutes":   durationConverter{unit: time.Minute},
+		"duration_seconds":   durationConverter{unit: time.Second},
+		"epoch_milliseconds": epochConverter{unit: time.Millisecond},
+		"epoch_seconds":      epochConverter{unit: time.Second},
+		"iso8601":            iso8601Converter{},
+		// The case converters are named for the case of the AWS value:
+		// "lowercase" expands a Terraform value to lowercase and flattens an AWS value to uppercase, and "uppercase" the reverse.
+		"lowercase": caseConverter{expand: strings.ToLower, flatten: strings.ToUpper},
+		"uppercase": caseConverter{expand: strings.ToUpper, flatten: strings.ToLower},
+	},
+}
+
+// RegisterConverter registers a named Converter with AutoFlex.
+// RegisterConverter panics if a converter is already registered with the name.
+// It is typically called from a service package's `init` function.
+func RegisterConverter(name string, converter Converter) {
+	converters.mu.Lock()
+	defer converters.mu.Unlock()
+
+	if _, ok := converters.converters[name]; ok {
+		panic(fmt.Sprintf("converter %q is already registered", name))
+	}
+	converters.converters[name] = converter
+}
+
+func converterByName(name string) (Converter, bool) {
+	converters.mu.RLock()
+	defer converters.mu.RUnlock()
+
+	converter, ok := converters.converters[name]
+	return converter, ok
+}
+
+// expandConverted expands the Plugin Framework value `vFrom` to `vTo` using the named converter.
+func expandConverted(ctx context.Context, name string, vFrom attr.Value, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyConverter, name)
+
+	converter, ok := converterByName(name)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "Converter not found")
+		diags.Append(diagConverterNotFound(name))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using converter")
+
+	v, d := converter.Expand(ctx, vFrom, vTo.Type())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v == nil {
+		return diags
+	}
+
+	val, tTo := reflect.ValueOf(v), vTo.Type()
+	switch {
+	case val.Type().AssignableTo(tTo):
+		vTo.Set(val)
+	case convertibleTo(val.Type(), tTo):
+		if overflows(val, tTo) {
+			tflog.SubsystemError(ctx, subsystemName, "Converted value overflows target", map[string]any{
+				"converted_type": fullTypeName(val.Type()),
+			})
+			diags.Append(diagConvertedValueOverflows(val, tTo))
+			return diags
+		}
+		vTo.Set(val.Convert(tTo))
+	case tTo.Kind() == reflect.Pointer && convertibleTo(val.Type(), tTo.Elem()):
+		if overflows(val, tTo.Elem()) {
+			tflog.SubsystemError(ctx, subsystemName, "Converted value overflows target", map[string]any{
+				"converted_type": fullTypeName(val.Type()),
+			})
+			diags.Append(diagConvertedValueOverflows(val, tTo.Elem()))
+			return diags
+		}
+		ptr := reflect.New(tTo.Elem())
+		ptr.Elem().Set(val.Convert(tTo.Elem()))
+		vTo.Set(ptr)
+	default:
+		tflog.SubsystemError(ctx, subsystemName, "Converted value cannot be assigned to target", map[string]any{
+			"converted_type": fullTypeName(val.Type()),
+		})
+		diags.Append(diagCannotBeAssigned(val.Type(), tTo))
+	}
+
+	return diags
+}
+
+// convertibleTo returns whether a converted value of type `from` can be converted to type `to`.
+// Unlike reflect.Type.ConvertibleTo, an integer is not convertible to a string.
+func convertibleTo(from, to reflect.Type) bool {
+	if isInteger(from.Kind()) && to.Kind() == reflect.String {
+		return false
+	}
+
+	return from.ConvertibleTo(to)
+}
+
+// overflows returns whether the converted integer value `val` cannot be represented by integer type `t`.
+func overflows(val reflect.Value, t reflect.Type) bool {
+	if !isInteger(val.Kind()) || !isInteger(t.Kind()) {
+		return false
+	}
+
+	to := reflect.Zero(t)
+	switch {
+	case val.CanInt() && to.CanInt():
+		return to.OverflowInt(val.Int())
+	case val.CanInt():
+		return val.Int() < 0 || to.OverflowUint(uint64(val.Int()))
+	case to.CanInt():
+		return val.Uint() > math.MaxInt64 || to.OverflowInt(int64(val.Uint()))
+	default:
+		return to.OverflowUint(val.Uint())
+	}
+}
+
+func isInteger(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// flattenConverted flattens the AWS value `vFrom` to the Plugin Framework value `vTo`, of type `tTo`, using the named converter.
+func flattenConverted(ctx context.Context, name string, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyConverter, name)
+
+	converter, ok := converterByName(name)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "Converter not found")
+		diags.Append(diagConverterNotFound(name))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using converter")
+
+	for vFrom.Kind() == reflect.Pointer && !vFrom.IsNil() {
+		vFrom = vFrom.Elem()
+	}
+
+	var tfValue tftypes.Value
+	if !vFrom.IsValid() || (slices.Contains([]reflect.Kind{reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice}, vFrom.Kind()) && vFrom.IsNil()) {
+		tflog.SubsystemTrace(ctx, subsystemName, "Flattening null value")
+		tfValue = tftypes.NewValue(tTo.TerraformType(ctx), nil)
+	} else {
+		v, d := converter.Flatten(ctx, vFrom.Interface())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var err error
+		tfValue, err = v.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Converting value", err.Error())
+			return diags
+		}
+	}
+
+	v, err := tTo.ValueFromTerraform(ctx, tfValue)
+	if err != nil {
+		diags.AddError("Converting value", err.Error())
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(v))
+
+	return diags
+}
+
+// base64Converter converts between a base64-encoded Terraform string and AWS bytes.
+type base64Converter struct{}
+
+func (base64Converter) Expand(ctx context.Context, v attr.Value, _ reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		diags.AddError("Decoding base64", err.Error())
+		return nil, diags
+	}
+
+	return b, diags
+}
+
+func (base64Converter) Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, ok := v.([]byte)
+	if !ok {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return nil, diags
+	}
+
+	return types.StringValue(base64.StdEncoding.EncodeToString(b)), diags
+}
+
+// durationConverter converts between a Terraform duration string, e.g. `1h30m`, and an AWS integer number of units.
+// Use `timetypes.GoDuration` for the Terraform attribute so that equivalent durations are semantically equal.
+type durationConverter struct {
+	unit time.Duration
+}
+
+func (c durationConverter) Expand(ctx context.Context, v attr.Value, _ reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		diags.AddError("Parsing duration", err.Error())
+		return nil, diags
+	}
+
+	return int64(duration / c.unit), diags
+}
+
+func (c durationConverter) Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	n, ok := int64FromAny(v)
+	if !ok {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return nil, diags
+	}
+
+	return types.StringValue((time.Duration(n) * c.unit).String()), diags
+}
+
+// epochConverter converts between a Terraform RFC3339 timestamp and an AWS integer number of units since the Unix epoch.
+type epochConverter struct {
+	unit time.Duration
+}
+
+func (c epochConverter) Expand(ctx context.Context, v attr.Value, _ reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		diags.AddError("Parsing timestamp", err.Error())
+		return nil, diags
+	}
+
+	return t.UnixNano() / int64(c.unit), diags
+}
+
+func (c epochConverter) Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	n, ok := int64FromAny(v)
+	if !ok {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return nil, diags
+	}
+
+	return types.StringValue(time.Unix(0, n*int64(c.unit)).UTC().Format(time.RFC3339)), diags
+}
+
+// iso8601Converter converts between a Terraform RFC3339 timestamp and an AWS ISO 8601 timestamp string,
+// which may omit the time zone or use a numeric offset without a colon.
+type iso8601Converter struct{}
+
+var iso8601Layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+func (iso8601Converter) Expand(ctx context.Context, v attr.Value, _ reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		diags.AddError("Parsing timestamp", err.Error())
+		return nil, diags
+	}
+
+	return t.Format(time.RFC3339), diags
+}
+
+func (iso8601Converter) Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.String {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return nil, diags
+	}
+
+	for _, layout := range iso8601Layouts {
+		if t, err := time.Parse(layout, val.String()); err == nil {
+			return types.StringValue(t.UTC().Format(time.RFC3339)), diags
+		}
+	}
+
+	diags.AddError("Parsing timestamp", fmt.Sprintf("%q is not an ISO 8601 timestamp", val.String()))
+	return nil, diags
+}
+
+// caseConverter converts the case of a string, expanding a Terraform value with `expand` to the AWS value
+// and flattening an AWS value with `flatten` to the Terraform value.
+type caseConverter struct {
+	expand  func(string) string
+	flatten func(string) string
+}
+
+func (c caseConverter) Expand(ctx context.Context, v attr.Value, _ reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return c.expand(s), diags
+}
+
+func (c caseConverter) Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.String {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return nil, diags
+	}
+
+	return types.StringValue(c.flatten(val.String())), diags
+}
+
+// JSONDocumentConverter returns a Converter between a Terraform JSON string and an AWS Smithy document,
+// e.g. `document.Interface`, created by the AWS service package's `NewLazyDocument` function.
+// As each AWS service package has its own document type, the converter must be registered per service:
+//
+//	func init() {
+//		fwflex.RegisterConverter("bedrockagent_document", fwflex.JSONDocumentConverter(document.NewLazyDocument))
+//	}
+func JSONDocumentConverter[T smithyjson.JSONStringer](newDocument func(any) T) Converter {
+	return jsonDocumentConverter[T]{
+		newDocument: newDocument,
+	}
+}
+
+type jsonDocumentConverter[T smithyjson.JSONStringer] struct {
+	newDocument func(any) T
+}
+
+func (c jsonDocumentConverter[T]) Expand(ctx context.Context, v attr.Value, _ reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, d := stringFromValue(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		diags.AddError("Unmarshalling JSON document", err.Error())
+		return nil, diags
+	}
+
+	return c.newDocument(value), diags
+}
+
+func (c jsonDocumentConverter[T]) Flatten(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	doc, ok := v.(smithyjson.JSONStringer)
+	if !ok {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return nil, diags
+	}
+
+	b, err := doc.MarshalSmithyDocument()
+	if err != nil {
+		diags.Append(diagFlatteningMarshalSmithyDocument(reflect.TypeOf(doc), err))
+		return nil, diags
+	}
+
+	return types.StringValue(string(b)), diags
+}
+
+func stringFromValue(ctx context.Context, v attr.Value) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	valuable, ok := v.(basetypes.StringValuable)
+	if !ok {
+		diags.Append(diagConverterUnsupportedType(reflect.TypeOf(v)))
+		return "", diags
+	}
+
+	s, d := valuable.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return s.ValueString(), diags
+}
+
+func int64FromAny(v any) (int64, bool) {
+	switch val := reflect.ValueOf(v); val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return val.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(val.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+func diagConvertedValueOverflows(val reflect.Value, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Value Conversion Error",
+		fmt.Sprintf("The configured value converts to %v, which is out of range for %q.", val.Interface(), fullTypeName(targetType)),
+	)
+}
+
+func diagConverterNotFound(name string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("AutoFlex converter %q is not registered.", name),
+	)
+}
+
+func diagConverterUnsupportedType(typ reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Type %q is not supported by the AutoFlex converter.", fullTypeName(typ)),
+	)
+}
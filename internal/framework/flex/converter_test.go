@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConverters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		converter     string
+		value         attr.Value
+		targetType    reflect.Type
+		wantExpanded  any
+		flattenSource any
+		wantFlattened attr.Value
+		expectError   bool
+	}{
+		"base64": {
+			converter:     "base64",
+			value:         types.StringValue("aGVsbG8="),
+			targetType:    reflect.TypeFor[[]byte](),
+			wantExpanded:  []byte("hello"),
+			flattenSource: []byte("hello"),
+			wantFlattened: types.StringValue("aGVsbG8="),
+		},
+		"base64 invalid": {
+			converter:   "base64",
+			value:       types.StringValue("not base64!"),
+			targetType:  reflect.TypeFor[[]byte](),
+			expectError: true,
+		},
+		"duration_minutes": {
+			converter:     "duration_minutes",
+			value:         types.StringValue("2h"),
+			targetType:    reflect.TypeFor[int32](),
+			wantExpanded:  int64(120),
+			flattenSource: int32(90),
+			wantFlattened: types.StringValue("1h30m0s"),
+		},
+		"duration_seconds invalid": {
+			converter:   "duration_seconds",
+			value:       types.StringValue("90"),
+			targetType:  reflect.TypeFor[int32](),
+			expectError: true,
+		},
+		"epoch_milliseconds": {
+			converter:     "epoch_milliseconds",
+			value:         types.StringValue("2025-01-02T03:04:05Z"),
+			targetType:    reflect.TypeFor[int64](),
+			wantExpanded:  int64(1735787045000),
+			flattenSource: int64(1735787045000),
+			wantFlattened: types.StringValue("2025-01-02T03:04:05Z"),
+		},
+		"iso8601": {
+			converter:     "iso8601",
+			value:         types.StringValue("2025-01-02T03:04:05Z"),
+			targetType:    reflect.TypeFor[string](),
+			wantExpanded:  "2025-01-02T03:04:05Z",
+			flattenSource: "2025-01-02T05:04:05+0200",
+			wantFlattened: types.StringValue("2025-01-02T03:04:05Z"),
+		},
+		"iso8601 date only": {
+			converter:     "iso8601",
+			value:         types.StringValue("2025-01-02T00:00:00Z"),
+			targetType:    reflect.TypeFor[string](),
+			wantExpanded:  "2025-01-02T00:00:00Z",
+			flattenSource: "2025-01-02",
+			wantFlattened: types.StringValue("2025-01-02T00:00:00Z"),
+		},
+		"lowercase": {
+			converter:     "lowercase",
+			value:         types.StringValue("ENABLED"),
+			targetType:    reflect.TypeFor[string](),
+			wantExpanded:  "enabled",
+			flattenSource: "enabled",
+			wantFlattened: types.StringValue("ENABLED"),
+		},
+		"unsupported type": {
+			converter:   "uppercase",
+			value:       types.Int64Value(1),
+			targetType:  reflect.TypeFor[string](),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			converter, ok := converterByName(testCase.converter)
+			if !ok {
+				t.Fatalf("converter %q not found", testCase.converter)
+			}
+
+			expanded, diags := converter.Expand(ctx, testCase.value, testCase.targetType)
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("Expand HasError = %t, want %t: %v", got, want, diags)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(expanded, testCase.wantExpanded); diff != "" {
+				t.Errorf("unexpected expanded diff (+wanted, -got): %s", diff)
+			}
+
+			flattened, diags := converter.Flatten(ctx, testCase.flattenSource)
+			if diags.HasError() {
+				t.Fatalf("unexpected Flatten error: %v", diags)
+			}
+
+			if diff := cmp.Diff(flattened, testCase.wantFlattened); diff != "" {
+				t.Errorf("unexpected flattened diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandConverterNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var target string
+	diags := expandConverted(ctx, "no_such_converter", types.StringValue("value"), reflect.ValueOf(&target).Elem())
+
+	if diff := cmp.Diff(diags, diag.Diagnostics{diagConverterNotFound("no_such_converter")}); diff != "" {
+		t.Errorf("unexpected diagnostics diff (+wanted, -got): %s", diff)
+	}
+}
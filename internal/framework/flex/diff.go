@@ -12,12 +12,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
 type Results struct {
 	hasChanges            bool
 	ignoredFieldNames     []string
 	flexIgnoredFieldNames []AutoFlexOptionsFunc
+	changes               []fieldChange
+}
+
+// fieldChange is a changed field and its plan and state values
+type fieldChange struct {
+	field reflect.StructField
+	plan  attr.Value
+	state attr.Value
 }
 
 // HasChanges returns whether there are changes between the plan and state values
@@ -38,6 +47,13 @@ func (r *Results) IgnoredFieldNames() []string {
 	return r.ignoredFieldNames
 }
 
+// ChangedFieldNames returns the list of changed field names
+func (r *Results) ChangedFieldNames() []string {
+	return tfslices.ApplyToAll(r.changes, func(v fieldChange) string {
+		return v.field.Name
+	})
+}
+
 // Diff compares the plan and state values and returns whether there are changes
 func Diff(ctx context.Context, plan, state any, options ...ChangeOption) (*Results, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -46,6 +62,7 @@ func Diff(ctx context.Context, plan, state any, options ...ChangeOption) (*Resul
 	planValue, stateValue := dereferencePointer(reflect.ValueOf(plan)), dereferencePointer(reflect.ValueOf(state))
 	planType, stateType := planValue.Type(), stateValue.Type()
 	var ignoredFields []string
+	var changes []fieldChange
 	result := Results{}
 
 	if planType != stateType {
@@ -82,6 +99,11 @@ func Diff(ctx context.Context, plan, state any, options ...ChangeOption) (*Resul
 
 		if !planFieldValue.Equal(stateFieldValue) {
 			hasChanges = true
+			changes = append(changes, fieldChange{
+				field: field,
+				plan:  planFieldValue,
+				state: stateFieldValue,
+			})
 		} else {
 			ignoredFields = append(ignoredFields, fieldName)
 		}
@@ -89,6 +111,7 @@ func Diff(ctx context.Context, plan, state any, options ...ChangeOption) (*Resul
 
 	result.hasChanges = hasChanges
 	result.ignoredFieldNames = ignoredFields
+	result.changes = changes
 
 	return &result, diags
 }
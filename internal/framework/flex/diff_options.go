@@ -30,3 +30,32 @@ func NewChangeOptions(options ...ChangeOption) *ChangeOptions {
 
 	return opts
 }
+
+// PatchOption is a type alias for a functional option that modifies PatchOptions
+type PatchOption func(*PatchOptions)
+
+// PatchOptions holds configuration for generating patch operations from plan changes
+type PatchOptions struct {
+	Paths map[string]string
+}
+
+// WithPatchPath specifies the patch path of a field, overriding the default of the field's
+// Terraform attribute name in lower camel case, e.g. `/cloudwatchRoleArn` for `cloudwatch_role_arn`
+func WithPatchPath(fieldName, path string) PatchOption {
+	return func(o *PatchOptions) {
+		o.Paths[fieldName] = path
+	}
+}
+
+// NewPatchOptions initializes PatchOptions with the provided options
+func NewPatchOptions(options ...PatchOption) *PatchOptions {
+	opts := &PatchOptions{
+		Paths: make(map[string]string),
+	}
+
+	for _, opt := range options {
+		opt(opts)
+	}
+
+	return opts
+}
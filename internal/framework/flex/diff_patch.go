@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Many AWS API update operations replace a resource's settings with those in the input.
+// Sending the full plan overwrites settings made outside Terraform, so these functions
+// build update inputs containing only the fields changed between plan and state, as reported by Diff.
+
+// ExpandChanges expands the changed fields of the plan into the AWS API update operation input `target`.
+// Unchanged fields are not expanded. Identifiers are typically unchanged and must be set on the input by the caller.
+//
+// As a field changed to null is not expanded, the input's corresponding `Clear<Field>` flag,
+// e.g. `ClearMaxDevices` for `MaxDevices`, is set if there is one.
+func ExpandChanges(ctx context.Context, plan any, diff *Results, target any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	optFns = append(tfslices.ApplyToAll(diff.ignoredFieldNames, WithIgnoredFieldNamesAppend), optFns...)
+	diags.Append(Expand(ctx, plan, target, optFns...)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo := reflect.ValueOf(target)
+	if valTo.Kind() != reflect.Pointer || valTo.IsNil() || valTo.Elem().Kind() != reflect.Struct {
+		return diags
+	}
+	valTo = valTo.Elem()
+
+	for _, change := range diff.changes {
+		if !change.plan.IsNull() {
+			continue
+		}
+
+		name := "Clear" + change.field.Name
+		field, ok := valTo.Type().FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(s, name)
+		})
+		if !ok {
+			continue
+		}
+
+		switch v := valTo.FieldByIndex(field.Index); {
+		case v.Kind() == reflect.Bool:
+			v.SetBool(true)
+		case v.Type() == reflect.TypeFor[*bool]():
+			v.Set(reflect.ValueOf(aws.Bool(true)))
+		}
+	}
+
+	return diags
+}
+
+// ExpandPatchOperations returns patch operations, e.g. API Gateway's `[]awstypes.PatchOperation`, for the changed fields.
+// T must be a struct with `Op`, `Path` and `Value` fields.
+//
+// A changed list or set of strings is patched element by element, removing and adding elements,
+// e.g. `/binaryMediaTypes/image~1png`. Any other changed field is replaced, with no value if it was changed to null.
+// For a field with the `legacy` AutoFlex option, an empty string is treated as null.
+func ExpandPatchOperations[T any](ctx context.Context, diff *Results, options ...PatchOption) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	opts := NewPatchOptions(options...)
+
+	typ := reflect.TypeFor[T]()
+	if err := checkPatchOperationType(typ); err != nil {
+		diags.AddError("Invalid patch operation type", err.Error())
+		return nil, diags
+	}
+
+	newOperation := func(op, path string, value *string) T {
+		var operation T
+		v := reflect.ValueOf(&operation).Elem()
+		v.FieldByName("Op").SetString(op)
+		v.FieldByName("Path").Set(reflect.ValueOf(aws.String(path)))
+		if value != nil {
+			v.FieldByName("Value").Set(reflect.ValueOf(value))
+		}
+		return operation
+	}
+
+	var operations []T
+	for _, change := range diff.changes {
+		path := opts.path(change.field)
+
+		plan, err := change.plan.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Converting plan value", err.Error())
+			return nil, diags
+		}
+		state, err := change.state.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Converting state value", err.Error())
+			return nil, diags
+		}
+
+		if isStringCollection(plan.Type()) {
+			o, err := stringElements(state)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Converting %s state value", change.field.Name), err.Error())
+				return nil, diags
+			}
+			n, err := stringElements(plan)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Converting %s plan value", change.field.Name), err.Error())
+				return nil, diags
+			}
+
+			for _, v := range o {
+				if !slices.Contains(n, v) {
+					operations = append(operations, newOperation("remove", path+"/"+escapeJSONPointer(v), nil))
+				}
+			}
+			for _, v := range n {
+				if !slices.Contains(o, v) {
+					operations = append(operations, newOperation("add", path+"/"+escapeJSONPointer(v), nil))
+				}
+			}
+
+			continue
+		}
+
+		value, err := tftypesValueToJSON(plan)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Converting %s plan value", change.field.Name), err.Error())
+			return nil, diags
+		}
+
+		switch v := value.(type) {
+		case nil:
+			operations = append(operations, newOperation("replace", path, nil))
+		case string:
+			if _, tagOpts := autoflexTags(change.field); tagOpts.Legacy() && v == "" {
+				operations = append(operations, newOperation("replace", path, nil))
+			} else {
+				operations = append(operations, newOperation("replace", path, aws.String(v)))
+			}
+		case bool:
+			operations = append(operations, newOperation("replace", path, aws.String(strconv.FormatBool(v))))
+		case json.Number:
+			operations = append(operations, newOperation("replace", path, aws.String(v.String())))
+		default:
+			diags.AddError("Unsupported patch value", fmt.Sprintf("field %s of type %s cannot be patched", change.field.Name, plan.Type()))
+			return nil, diags
+		}
+	}
+
+	return operations, diags
+}
+
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// ExpandJSONPatch returns an RFC 6902 JSON Patch document for the changed fields,
+// e.g. for the Cloud Control API's `UpdateResource` operation.
+// A field changed from null is added, a field changed to null is removed, and any other changed field is replaced.
+// Nested object attribute names are converted to lower camel case.
+func ExpandJSONPatch(ctx context.Context, diff *Results, options ...PatchOption) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	opts := NewPatchOptions(options...)
+
+	operations := make([]jsonPatchOperation, 0, len(diff.changes))
+	for _, change := range diff.changes {
+		path := opts.path(change.field)
+
+		if change.plan.IsNull() {
+			operations = append(operations, jsonPatchOperation{
+				Op:   "remove",
+				Path: path,
+			})
+			continue
+		}
+
+		plan, err := change.plan.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError("Converting plan value", err.Error())
+			return "", diags
+		}
+
+		value, err := tftypesValueToJSON(plan)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Converting %s plan value", change.field.Name), err.Error())
+			return "", diags
+		}
+
+		op := "replace"
+		if change.state.IsNull() {
+			op = "add"
+		}
+		operations = append(operations, jsonPatchOperation{
+			Op:    op,
+			Path:  path,
+			Value: value,
+		})
+	}
+
+	b, err := json.Marshal(operations)
+	if err != nil {
+		diags.AddError("Marshalling JSON Patch", err.Error())
+		return "", diags
+	}
+
+	return string(b), diags
+}
+
+func (o *PatchOptions) path(field reflect.StructField) string {
+	if v, ok := o.Paths[field.Name]; ok {
+		return v
+	}
+	return "/" + lowerCamelCase(tfsdkName(field))
+}
+
+func checkPatchOperationType(typ reflect.Type) error {
+	if typ.Kind() != reflect.Struct {
+		return fmt.Errorf("%s is not a struct", fullTypeName(typ))
+	}
+	if field, ok := typ.FieldByName("Op"); !ok || field.Type.Kind() != reflect.String {
+		return fmt.Errorf("%s has no string Op field", fullTypeName(typ))
+	}
+	for _, name := range []string{"Path", "Value"} {
+		if field, ok := typ.FieldByName(name); !ok || field.Type != reflect.TypeFor[*string]() {
+			return fmt.Errorf("%s has no *string %s field", fullTypeName(typ), name)
+		}
+	}
+	return nil
+}
+
+func isStringCollection(typ tftypes.Type) bool {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		return typ.ElementType.Is(tftypes.String)
+	case tftypes.Set:
+		return typ.ElementType.Is(tftypes.String)
+	default:
+		return false
+	}
+}
+
+func stringElements(v tftypes.Value) ([]string, error) {
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAllWithError(elems, func(v tftypes.Value) (string, error) {
+		var s string
+		err := v.As(&s)
+		return s, err
+	})
+}
+
+// tftypesValueToJSON returns the value as a value that encoding/json marshals.
+func tftypesValueToJSON(v tftypes.Value) (any, error) {
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	typ := v.Type()
+	switch typ.(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		return tfslices.ApplyToAllWithError(elems, tftypesValueToJSON)
+
+	case tftypes.Map, tftypes.Object:
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		_, isObject := typ.(tftypes.Object)
+		result := make(map[string]any, len(elems))
+		for k, elem := range elems {
+			value, err := tftypesValueToJSON(elem)
+			if err != nil {
+				return nil, err
+			}
+			if isObject {
+				k = lowerCamelCase(k)
+			}
+			result[k] = value
+		}
+		return result, nil
+
+	default:
+		switch {
+		case typ.Is(tftypes.String):
+			var s string
+			err := v.As(&s)
+			return s, err
+		case typ.Is(tftypes.Bool):
+			var b bool
+			err := v.As(&b)
+			return b, err
+		case typ.Is(tftypes.Number):
+			var f big.Float
+			if err := v.As(&f); err != nil {
+				return nil, err
+			}
+			return json.Number(f.Text('f', -1)), nil
+		default:
+			return nil, fmt.Errorf("unsupported type %s", typ)
+		}
+	}
+}
+
+// lowerCamelCase converts a Terraform attribute name to lower camel case, e.g. `cloudwatchRoleArn` for `cloudwatch_role_arn`.
+func lowerCamelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i, part := range parts[1:] {
+		if part != "" {
+			parts[i+1] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// escapeJSONPointer escapes a JSON Pointer reference token per RFC 6901.
+func escapeJSONPointer(s string) string {
+	s = strings.ReplaceAll(s, "~", "~0")
+	s = strings.ReplaceAll(s, "/", "~1")
+	return s
+}
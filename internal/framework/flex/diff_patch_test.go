@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type testPatchResourceData struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	MaxDevices  types.Int64  `tfsdk:"max_devices"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	RoleARN     types.String `tfsdk:"role_arn" autoflex:",legacy"`
+}
+
+type testPatchSetResourceData struct {
+	MediaTypes fwtypes.SetOfString `tfsdk:"media_types"`
+}
+
+type testUpdateInput struct {
+	ID              *string
+	Description     *string
+	MaxDevices      *int64
+	ClearMaxDevices *bool
+	Enabled         *bool
+	RoleARN         *string
+}
+
+type testPatchOp string
+
+type testPatchOperation struct {
+	From  *string
+	Op    testPatchOp
+	Path  *string
+	Value *string
+}
+
+func TestExpandChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		plan                      testPatchResourceData
+		state                     testPatchResourceData
+		expectedChangedFieldNames []string
+		expected                  testUpdateInput
+	}{
+		"no change": {
+			plan:                      testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Value(1)},
+			state:                     testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Value(1)},
+			expectedChangedFieldNames: []string{},
+			expected:                  testUpdateInput{},
+		},
+		"changed value": {
+			plan:                      testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test2"), MaxDevices: types.Int64Value(1)},
+			state:                     testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Value(1)},
+			expectedChangedFieldNames: []string{"Description"},
+			expected: testUpdateInput{
+				Description: aws.String("test2"),
+			},
+		},
+		"changed to null": {
+			plan:                      testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Null()},
+			state:                     testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Value(1)},
+			expectedChangedFieldNames: []string{"MaxDevices"},
+			expected: testUpdateInput{
+				ClearMaxDevices: aws.Bool(true),
+			},
+		},
+		"changed from null": {
+			plan:                      testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Value(2)},
+			state:                     testPatchResourceData{ID: types.StringValue("id"), Description: types.StringValue("test"), MaxDevices: types.Int64Null()},
+			expectedChangedFieldNames: []string{"MaxDevices"},
+			expected: testUpdateInput{
+				MaxDevices: aws.Int64(2),
+			},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := fwflex.Diff(ctx, test.plan, test.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(results.ChangedFieldNames(), test.expectedChangedFieldNames); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			var input testUpdateInput
+			diags = fwflex.ExpandChanges(ctx, test.plan, results, &input)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(input, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandPatchOperations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		plan      any
+		state     any
+		opts      []fwflex.PatchOption
+		expected  []testPatchOperation
+		expectErr bool
+	}{
+		"no change": {
+			plan:  testPatchResourceData{Description: types.StringValue("test")},
+			state: testPatchResourceData{Description: types.StringValue("test")},
+		},
+		"replace scalars": {
+			plan:  testPatchResourceData{Description: types.StringValue("test2"), MaxDevices: types.Int64Value(2), Enabled: types.BoolValue(false)},
+			state: testPatchResourceData{Description: types.StringValue("test"), MaxDevices: types.Int64Value(1), Enabled: types.BoolValue(true)},
+			expected: []testPatchOperation{
+				{Op: "replace", Path: aws.String("/description"), Value: aws.String("test2")},
+				{Op: "replace", Path: aws.String("/maxDevices"), Value: aws.String("2")},
+				{Op: "replace", Path: aws.String("/enabled"), Value: aws.String("false")},
+			},
+		},
+		"replace with null": {
+			plan:  testPatchResourceData{Description: types.StringNull(), RoleARN: types.StringValue("")},
+			state: testPatchResourceData{Description: types.StringValue("test"), RoleARN: types.StringValue("arn:aws:iam::123456789012:role/test")},
+			expected: []testPatchOperation{
+				{Op: "replace", Path: aws.String("/description")},
+				{Op: "replace", Path: aws.String("/roleArn")},
+			},
+		},
+		"path option": {
+			plan:  testPatchResourceData{Description: types.StringValue("test2")},
+			state: testPatchResourceData{Description: types.StringValue("test")},
+			opts:  []fwflex.PatchOption{fwflex.WithPatchPath("Description", "/info/description")},
+			expected: []testPatchOperation{
+				{Op: "replace", Path: aws.String("/info/description"), Value: aws.String("test2")},
+			},
+		},
+		"string set elements": {
+			plan:  testPatchSetResourceData{MediaTypes: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("image/png"), types.StringValue("text/plain")})},
+			state: testPatchSetResourceData{MediaTypes: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("image/png"), types.StringValue("application/json")})},
+			expected: []testPatchOperation{
+				{Op: "remove", Path: aws.String("/mediaTypes/application~1json")},
+				{Op: "add", Path: aws.String("/mediaTypes/text~1plain")},
+			},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := fwflex.Diff(ctx, test.plan, test.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			operations, diags := fwflex.ExpandPatchOperations[testPatchOperation](ctx, results, test.opts...)
+
+			if diff := cmp.Diff(diags.HasError(), test.expectErr); diff != "" {
+				t.Fatalf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(operations, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandPatchOperations_invalidType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := fwflex.Diff(ctx, testPatchResourceData{}, testPatchResourceData{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	_, diags = fwflex.ExpandPatchOperations[testUpdateInput](ctx, results)
+	if !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestExpandJSONPatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		plan     any
+		state    any
+		expected string
+	}{
+		"no change": {
+			plan:     testPatchResourceData{Description: types.StringValue("test")},
+			state:    testPatchResourceData{Description: types.StringValue("test")},
+			expected: `[]`,
+		},
+		"add, replace and remove": {
+			plan:     testPatchResourceData{Description: types.StringValue("test2"), MaxDevices: types.Int64Value(2), Enabled: types.BoolNull()},
+			state:    testPatchResourceData{Description: types.StringValue("test"), MaxDevices: types.Int64Null(), Enabled: types.BoolValue(true)},
+			expected: `[{"op":"replace","path":"/description","value":"test2"},{"op":"add","path":"/maxDevices","value":2},{"op":"remove","path":"/enabled"}]`,
+		},
+		"false value": {
+			plan:     testPatchResourceData{Enabled: types.BoolValue(false)},
+			state:    testPatchResourceData{Enabled: types.BoolValue(true)},
+			expected: `[{"op":"replace","path":"/enabled","value":false}]`,
+		},
+		"string set": {
+			plan:     testPatchSetResourceData{MediaTypes: fwtypes.NewSetValueOfMust[types.String](ctx, []attr.Value{types.StringValue("image/png")})},
+			state:    testPatchSetResourceData{MediaTypes: fwtypes.NewSetValueOfNull[types.String](ctx)},
+			expected: `[{"op":"add","path":"/mediaTypes","value":["image/png"]}]`,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := fwflex.Diff(ctx, test.plan, test.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			got, diags := fwflex.ExpandJSONPatch(ctx, results)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ const (
 	logAttrKeyTargetFieldname = "autoflex.target.fieldname"
 	logAttrKeyTargetPath      = "autoflex.target.path"
 
+	logAttrKeyConverter = "autoflex.converter"
+
 	logAttrKeyError = "error"
 )
 
@@ -15,7 +15,14 @@ type tagOptions string
 
 // parseTag splits a struct field's json tag into its name and
 // comma-separated options.
+// A tag that starts with a key=value option, e.g. "name=VpcConfiguration", has no name.
 func parseTag(tag string) (string, tagOptions) {
+	if strings.Contains(tag, "=") {
+		if name, opt, _ := strings.Cut(tag, ","); !strings.Contains(name, "=") {
+			return name, tagOptions(opt)
+		}
+		return "", tagOptions(tag)
+	}
 	tag, opt, _ := strings.Cut(tag, ",")
 	return tag, tagOptions(opt)
 }
@@ -38,6 +45,29 @@ func (o tagOptions) Contains(optionName string) bool {
 	return false
 }
 
+// Value returns the value of a key=value option.
+func (o tagOptions) Value(key string) string {
+	s := string(o)
+	for s != "" {
+		var option string
+		option, s, _ = strings.Cut(s, ",")
+		if k, v, ok := strings.Cut(option, "="); ok && k == key {
+			return v
+		}
+	}
+	return ""
+}
+
+// Name returns the name of the corresponding field, overriding fuzzy field name matching.
+func (o tagOptions) Name() string {
+	return o.Value("name")
+}
+
+// Converter returns the name of the registered Converter used to convert the field's value.
+func (o tagOptions) Converter() string {
+	return o.Value("convert")
+}
+
 func (o tagOptions) Legacy() bool {
 	return o.Contains("legacy")
 }
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// Smithy unions are represented in the AWS SDK for Go v2 as an interface, e.g. `LogSourceResource`,
+// implemented by one struct per union member, e.g. `LogSourceResourceMemberAwsLogSource`, whose `Value` field
+// holds the member's value.
+// Terraform has no union type, so a union is modeled as a nested object with one block (or attribute) per union
+// member, exactly one of which is set.
+
+const unionMemberValueFieldName = "Value"
+
+type unionMember struct {
+	name     string       // Union member name, e.g. AwsLogSource.
+	typ      reflect.Type // Union member type, e.g. *LogSourceResourceMemberAwsLogSource.
+	union    reflect.Type // Union interface type, e.g. LogSourceResource.
+	valueIdx []int        // Index of the union member's Value field.
+}
+
+type unionRegistry struct {
+	mu      sync.RWMutex
+	unions  map[reflect.Type][]unionMember // Keyed by union interface type.
+	members map[reflect.Type]unionMember   // Keyed by union member struct type.
+}
+
+var unions = unionRegistry{
+	unions:  make(map[reflect.Type][]unionMember),
+	members: make(map[reflect.Type]unionMember),
+}
+
+// RegisterUnion registers the member types of a Smithy union, represented as interface type T, with AutoFlex.
+// Each member's name is derived from its type name, e.g. `AwsLogSource` for `LogSourceResourceMemberAwsLogSource`.
+//
+// Once registered, Expand expands a nested object with one field per union member to the member whose
+// (case-insensitively) matching field is set, returning an error if not exactly one is set.
+// Flatten flattens a union member to the matching field of a nested object, setting all other fields to null.
+//
+// RegisterUnion panics if a member type is not a (pointer to a) struct named for the union with a `Value` field.
+// It is typically called from a service package's `init` function:
+//
+//	func init() {
+//		fwflex.RegisterUnion[awstypes.LogSourceResource](
+//			&awstypes.LogSourceResourceMemberAwsLogSource{},
+//			&awstypes.LogSourceResourceMemberCustomLogSource{},
+//		)
+//	}
+func RegisterUnion[T any](members ...T) {
+	union := reflect.TypeFor[T]()
+	if union.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("union type %s is not an interface", fullTypeName(union)))
+	}
+
+	unions.mu.Lock()
+	defer unions.mu.Unlock()
+
+	for _, member := range members {
+		typ := reflect.TypeOf(member)
+		structType := typ
+		if structType.Kind() == reflect.Pointer {
+			structType = structType.Elem()
+		}
+		if structType.Kind() != reflect.Struct {
+			panic(fmt.Sprintf("union member type %s is not a struct", fullTypeName(typ)))
+		}
+
+		name, ok := strings.CutPrefix(structType.Name(), union.Name()+"Member")
+		if !ok || name == "" {
+			panic(fmt.Sprintf("union member type %s is not named for union %s", fullTypeName(typ), fullTypeName(union)))
+		}
+
+		field, ok := structType.FieldByName(unionMemberValueFieldName)
+		if !ok {
+			panic(fmt.Sprintf("union member type %s has no %s field", fullTypeName(typ), unionMemberValueFieldName))
+		}
+
+		if _, ok := unions.members[structType]; ok {
+			continue
+		}
+
+		m := unionMember{
+			name:     name,
+			typ:      typ,
+			union:    union,
+			valueIdx: field.Index,
+		}
+		unions.unions[union] = append(unions.unions[union], m)
+		unions.members[structType] = m
+	}
+}
+
+// unionMembers returns the registered members of the specified union interface type.
+func unionMembers(union reflect.Type) ([]unionMember, bool) {
+	unions.mu.RLock()
+	defer unions.mu.RUnlock()
+
+	members, ok := unions.unions[union]
+	return members, ok
+}
+
+// unionMemberOf returns the registered union member for the specified (pointer to) struct type.
+func unionMemberOf(typ reflect.Type) (unionMember, bool) {
+	if typ == nil {
+		return unionMember{}, false
+	}
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	unions.mu.RLock()
+	defer unions.mu.RUnlock()
+
+	member, ok := unions.members[typ]
+	return member, ok
+}
+
+// expandUnion expands the Plugin Framework struct `valFrom` to the union member corresponding to its single non-null, non-empty field.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, members []unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+
+	var (
+		fields  []string
+		setName string
+		setIdx  []int
+		nSet    int
+	)
+	for field := range expandSourceFields(ctx, valFrom.Type(), flexer.getOptions()) {
+		fields = append(fields, tfsdkName(field))
+
+		v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		// Unconfigured nested blocks are empty rather than null.
+		if v, ok := v.(valueWithElementsAs); ok && len(v.Elements()) == 0 {
+			continue
+		}
+
+		nSet++
+		setName, setIdx = field.Name, field.Index
+	}
+
+	if nSet != 1 {
+		tflog.SubsystemError(ctx, subsystemName, "Expected exactly one union member", map[string]any{
+			logAttrKeySourceSize: nSet,
+		})
+		diags.Append(diagExpandingUnionMemberCount(fields, nSet))
+		return diags
+	}
+
+	var member unionMember
+	for _, m := range members {
+		if strings.EqualFold(m.name, setName) {
+			member = m
+			break
+		}
+	}
+	if member.typ == nil {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+			logAttrKeySourceFieldname: setName,
+		})
+		diags.Append(diagExpandingNoUnionMember(valFrom.Type(), setName, valTo.Type()))
+		return diags
+	}
+
+	var to reflect.Value
+	if member.typ.Kind() == reflect.Pointer {
+		to = reflect.New(member.typ.Elem())
+	} else {
+		to = reflect.New(member.typ)
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: setName,
+		logAttrKeyTargetType:      fullTypeName(member.typ),
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(setName), valFrom.FieldByIndex(setIdx), targetPath.AtName(unionMemberValueFieldName), to.Elem().FieldByIndex(member.valueIdx), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if member.typ.Kind() != reflect.Pointer {
+		to = to.Elem()
+	}
+	valTo.Set(to)
+
+	return diags
+}
+
+// flattenUnion flattens the union member `valFrom` to the corresponding field of the Plugin Framework struct `valTo`.
+// All other fields are set to null.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, member unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Source is a union member", map[string]any{
+		"union":  fullTypeName(member.union),
+		"member": member.name,
+	})
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if valTo.Kind() == reflect.Pointer {
+		valTo = valTo.Elem()
+	}
+
+	var toField reflect.StructField
+	for field := range tfreflect.ExportedStructFields(valTo.Type()) {
+		if name, _ := autoflexTags(field); name == "-" {
+			continue
+		}
+		if field.Name == member.name {
+			toField = field
+			break
+		}
+		if toField.Index == nil && strings.EqualFold(field.Name, member.name) {
+			toField = field
+		}
+	}
+
+	if toField.Index == nil {
+		tflog.SubsystemWarn(ctx, subsystemName, "No corresponding field for union member", map[string]any{
+			"member": member.name,
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceType:      fullTypeName(member.typ),
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByIndex(member.valueIdx), targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
+// tfsdkName returns the Terraform attribute name of a model field.
+func tfsdkName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+func quotedList(s []string) string {
+	q := make([]string, len(s))
+	for i, v := range s {
+		q[i] = strconv.Quote(v)
+	}
+	return strings.Join(q, ", ")
+}
+
+func diagExpandingUnionMemberCount(fields []string, n int) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Exactly one of %s must be specified, got %d.", quotedList(fields), n),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, fieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q field %q has no corresponding member in union %q.", fullTypeName(sourceType), fieldName, fullTypeName(targetType)),
+	)
+}
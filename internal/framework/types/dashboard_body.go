@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+type dashboardBodyKind struct{}
+
+func (dashboardBodyKind) typeName() string {
+	return "DashboardBodyType"
+}
+
+func (dashboardBodyKind) valueName() string {
+	return "Dashboard Body"
+}
+
+func (dashboardBodyKind) equivalent(s1, s2 string) bool {
+	return tfjson.DashboardBodiesEquivalent(s1, s2)
+}
+
+var (
+	DashboardBodyType = semanticJSONType[dashboardBodyKind]{}
+)
+
+type DashboardBody = SemanticJSON[dashboardBodyKind]
+
+func DashboardBodyNull() DashboardBody {
+	return semanticJSONNull[dashboardBodyKind]()
+}
+
+func DashboardBodyUnknown() DashboardBody {
+	return semanticJSONUnknown[dashboardBodyKind]()
+}
+
+func DashboardBodyValue(value string) DashboardBody {
+	return semanticJSONValue[dashboardBodyKind](value)
+}
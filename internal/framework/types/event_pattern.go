@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+type eventPatternKind struct{}
+
+func (eventPatternKind) typeName() string {
+	return "EventPatternType"
+}
+
+func (eventPatternKind) valueName() string {
+	return "Event Pattern"
+}
+
+func (eventPatternKind) equivalent(s1, s2 string) bool {
+	return tfjson.EventPatternsEquivalent(s1, s2)
+}
+
+var (
+	EventPatternType = semanticJSONType[eventPatternKind]{}
+)
+
+type EventPattern = SemanticJSON[eventPatternKind]
+
+func EventPatternNull() EventPattern {
+	return semanticJSONNull[eventPatternKind]()
+}
+
+func EventPatternUnknown() EventPattern {
+	return semanticJSONUnknown[eventPatternKind]()
+}
+
+func EventPatternValue(value string) EventPattern {
+	return semanticJSONValue[eventPatternKind](value)
+}
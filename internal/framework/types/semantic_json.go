@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// semanticJSONKind describes a kind of JSON document whose semantic equality is determined by the AWS service that owns it.
+type semanticJSONKind interface {
+	// typeName returns the human readable name of the type, e.g. "EventPatternType".
+	typeName() string
+	// valueName returns the human readable name of the value used in diagnostics, e.g. "Event Pattern".
+	valueName() string
+	// equivalent returns whether the JSON documents in the given strings are equivalent.
+	equivalent(s1, s2 string) bool
+}
+
+var (
+	_ basetypes.StringTypable = (*semanticJSONType[eventPatternKind])(nil)
+)
+
+type semanticJSONType[K semanticJSONKind] struct {
+	basetypes.StringType
+}
+
+func (t semanticJSONType[K]) Equal(o attr.Type) bool {
+	other, ok := o.(semanticJSONType[K])
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t semanticJSONType[K]) String() string {
+	var k K
+	return k.typeName()
+}
+
+func (t semanticJSONType[K]) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return semanticJSONNull[K](), diags
+	}
+	if in.IsUnknown() {
+		return semanticJSONUnknown[K](), diags
+	}
+
+	return SemanticJSON[K]{StringValue: in}, diags
+}
+
+func (t semanticJSONType[K]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t semanticJSONType[K]) ValueType(context.Context) attr.Value {
+	return SemanticJSON[K]{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*SemanticJSON[eventPatternKind])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SemanticJSON[eventPatternKind])(nil)
+	_ xattr.ValidateableAttribute                = (*SemanticJSON[eventPatternKind])(nil)
+)
+
+func semanticJSONNull[K semanticJSONKind]() SemanticJSON[K] {
+	return SemanticJSON[K]{StringValue: basetypes.NewStringNull()}
+}
+
+func semanticJSONUnknown[K semanticJSONKind]() SemanticJSON[K] {
+	return SemanticJSON[K]{StringValue: basetypes.NewStringUnknown()}
+}
+
+func semanticJSONValue[K semanticJSONKind](value string) SemanticJSON[K] {
+	return SemanticJSON[K]{StringValue: basetypes.NewStringValue(value)}
+}
+
+// SemanticJSON is a JSON string value whose semantic equality is determined by its kind.
+type SemanticJSON[K semanticJSONKind] struct {
+	basetypes.StringValue
+}
+
+func (v SemanticJSON[K]) Equal(o attr.Value) bool {
+	other, ok := o.(SemanticJSON[K])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SemanticJSON[K]) Type(context.Context) attr.Type {
+	return semanticJSONType[K]{}
+}
+
+func (v SemanticJSON[K]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SemanticJSON[K])
+
+	if !ok {
+		return false, diags
+	}
+
+	var k K
+	return k.equivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+func (v SemanticJSON[K]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		var k K
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid "+k.valueName()+" Value",
+			"The provided value is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestSemanticJSONValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         xattr.ValidateableAttribute
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"event pattern unknown": {
+			val: fwtypes.EventPatternUnknown(),
+		},
+		"event pattern null": {
+			val: fwtypes.EventPatternNull(),
+		},
+		"event pattern valid": {
+			val: fwtypes.EventPatternValue(`{"source": ["aws.ec2"]}`),
+		},
+		"event pattern invalid": {
+			val:         fwtypes.EventPatternValue("not ok"),
+			expectError: true,
+		},
+		"state machine definition unknown": {
+			val: fwtypes.StateMachineDefinitionUnknown(),
+		},
+		"state machine definition null": {
+			val: fwtypes.StateMachineDefinitionNull(),
+		},
+		"state machine definition valid": {
+			val: fwtypes.StateMachineDefinitionValue(`{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}`),
+		},
+		"state machine definition invalid": {
+			val:         fwtypes.StateMachineDefinitionValue("not ok"),
+			expectError: true,
+		},
+		"dashboard body unknown": {
+			val: fwtypes.DashboardBodyUnknown(),
+		},
+		"dashboard body null": {
+			val: fwtypes.DashboardBodyNull(),
+		},
+		"dashboard body valid": {
+			val: fwtypes.DashboardBodyValue(`{"widgets": []}`),
+		},
+		"dashboard body invalid": {
+			val:         fwtypes.DashboardBodyValue("not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestSemanticJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 basetypes.StringValuableWithSemanticEquals
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"event pattern not equals": {
+			val1: fwtypes.EventPatternValue(`{"source": ["aws.ec2"]}`),
+			val2: fwtypes.EventPatternValue(`{"source": ["aws.s3"]}`),
+		},
+		"event pattern equals": {
+			val1:   fwtypes.EventPatternValue(`{"source": ["aws.ec2", "aws.s3"], "detail-type": ["EC2 Instance State-change Notification"]}`),
+			val2:   fwtypes.EventPatternValue(`{"detail-type":["EC2 Instance State-change Notification"],"source":["aws.s3","aws.ec2"]}`),
+			equals: true,
+		},
+		"state machine definition not equals": {
+			val1: fwtypes.StateMachineDefinitionValue(`{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}`),
+			val2: fwtypes.StateMachineDefinitionValue(`{"StartAt": "B", "States": {"B": {"Type": "Pass", "End": true}}}`),
+		},
+		"state machine definition equals": {
+			val1:   fwtypes.StateMachineDefinitionValue(`{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "r", "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 3}], "End": true}}}`),
+			val2:   fwtypes.StateMachineDefinitionValue(`{"QueryLanguage": "JSONPath", "StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "r", "Retry": [{"ErrorEquals": ["States.ALL"]}], "End": true}}}`),
+			equals: true,
+		},
+		"dashboard body not equals": {
+			val1: fwtypes.DashboardBodyValue(`{"widgets": [{"type": "text", "x": 0, "y": 0, "properties": {"markdown": "a"}}]}`),
+			val2: fwtypes.DashboardBodyValue(`{"widgets": [{"type": "text", "x": 0, "y": 0, "properties": {"markdown": "b"}}]}`),
+		},
+		"dashboard body equals": {
+			val1:   fwtypes.DashboardBodyValue(`{"widgets": [{"type": "metric", "x": 0, "y": 0, "properties": {"metrics": [["AWS/EC2", "CPUUtilization"]], "region": "us-east-1"}}]}`),
+			val2:   fwtypes.DashboardBodyValue(`{"widgets": [{"type": "metric", "x": 0, "y": 0, "width": 6, "height": 6, "properties": {"metrics": [["AWS/EC2", "CPUUtilization"]], "region": "us-east-1", "view": "timeSeries", "stacked": false}}]}`),
+			equals: true,
+		},
+		"different kinds": {
+			val1: fwtypes.EventPatternValue(`{"widgets": []}`),
+			val2: fwtypes.DashboardBodyValue(`{"widgets": []}`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
+
+func TestSemanticJSONType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if got, want := fwtypes.EventPatternType.String(), "EventPatternType"; got != want {
+		t.Errorf("EventPatternType.String() = %v, want %v", got, want)
+	}
+	if got, want := fwtypes.EventPatternType.Equal(fwtypes.DashboardBodyType), false; got != want {
+		t.Errorf("EventPatternType.Equal(DashboardBodyType) = %v, want %v", got, want)
+	}
+	if got, want := fwtypes.StateMachineDefinitionValue("{}").Type(ctx), fwtypes.StateMachineDefinitionType; !got.Equal(want) {
+		t.Errorf("StateMachineDefinitionValue.Type() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+type stateMachineDefinitionKind struct{}
+
+func (stateMachineDefinitionKind) typeName() string {
+	return "StateMachineDefinitionType"
+}
+
+func (stateMachineDefinitionKind) valueName() string {
+	return "State Machine Definition"
+}
+
+func (stateMachineDefinitionKind) equivalent(s1, s2 string) bool {
+	return tfjson.StateMachineDefinitionsEquivalent(s1, s2)
+}
+
+var (
+	StateMachineDefinitionType = semanticJSONType[stateMachineDefinitionKind]{}
+)
+
+type StateMachineDefinition = SemanticJSON[stateMachineDefinitionKind]
+
+func StateMachineDefinitionNull() StateMachineDefinition {
+	return semanticJSONNull[stateMachineDefinitionKind]()
+}
+
+func StateMachineDefinitionUnknown() StateMachineDefinition {
+	return semanticJSONUnknown[stateMachineDefinitionKind]()
+}
+
+func StateMachineDefinitionValue(value string) StateMachineDefinition {
+	return semanticJSONValue[stateMachineDefinitionKind](value)
+}
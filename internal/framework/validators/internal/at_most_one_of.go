@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var (
+	_ validator.List   = AtMostOneOfValidator{}
+	_ validator.Object = AtMostOneOfValidator{}
+	_ validator.Set    = AtMostOneOfValidator{}
+	_ validator.String = AtMostOneOfValidator{}
+)
+
+// AtMostOneOfValidator differs from the terraform-plugin-framework-validators equivalent in that
+// an empty list or set, i.e. an omitted list or set nested block, is not considered to be set.
+type AtMostOneOfValidator struct {
+	PathExpressions path.Expressions
+}
+
+type AtMostOneOfValidatorRequest struct {
+	Config         tfsdk.Config
+	ConfigValue    attr.Value
+	Path           path.Path
+	PathExpression path.Expression
+}
+
+type AtMostOneOfValidatorResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+func (av AtMostOneOfValidator) Description(ctx context.Context) string {
+	return av.MarkdownDescription(ctx)
+}
+
+func (av AtMostOneOfValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Ensure that at most one attribute from this collection is set: %q", av.PathExpressions)
+}
+
+func (av AtMostOneOfValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateReq := AtMostOneOfValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp AtMostOneOfValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av AtMostOneOfValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	validateReq := AtMostOneOfValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp AtMostOneOfValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av AtMostOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateReq := AtMostOneOfValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp AtMostOneOfValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av AtMostOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateReq := AtMostOneOfValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp AtMostOneOfValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av AtMostOneOfValidator) Validate(ctx context.Context, req AtMostOneOfValidatorRequest, res *AtMostOneOfValidatorResponse) {
+	// If current attribute is unknown or not set, there is nothing to check.
+	// The diagnostic is reported by one of the other attributes in the collection.
+	if req.ConfigValue.IsUnknown() || isNullOrEmpty(ctx, req.ConfigValue) {
+		return
+	}
+
+	expressions := req.PathExpression.MergeExpressions(av.PathExpressions...)
+	var conflicts path.Paths
+
+	for _, expression := range expressions {
+		matchedPaths, diags := matchingPathValues(ctx, req.Config, expression, req.Path)
+		res.Diagnostics.Append(diags...)
+
+		// Collect all errors
+		if diags.HasError() {
+			continue
+		}
+
+		for _, mp := range matchedPaths {
+			// Delay validation until all involved attribute have a known value
+			if mp.value.IsUnknown() {
+				return
+			}
+
+			if !isNullOrEmpty(ctx, mp.value) {
+				conflicts = append(conflicts, mp.path)
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		res.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			req.Path,
+			fmt.Sprintf("Attribute %q cannot be specified when %s is specified", req.Path, conflicts),
+		))
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/internal"
+)
+
+func TestAtMostOneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	readPath := path.Root("configuration").AtListIndex(0).AtName("read_config")
+
+	type testCase struct {
+		config              tfsdk.Config
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"none set": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), nil, nil),
+			),
+		},
+		"one set": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), testBlock(readType, "a"), nil),
+			),
+		},
+		"other set": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), nil, testBlock(writeType, "b")),
+			),
+		},
+		"both set": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), testBlock(readType, "a"), testBlock(writeType, "b")),
+			),
+			expectedDiagnostics: diag.Diagnostics{
+				validatordiag.InvalidAttributeCombinationDiagnostic(
+					readPath,
+					`Attribute "configuration[0].read_config" cannot be specified when [configuration[0].write_config] is specified`,
+				),
+			},
+		},
+		"both set, other element": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), testBlock(readType, "a"), nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), nil, testBlock(writeType, "b")),
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := testListRequest(ctx, t, test.config, readPath)
+			response := validator.ListResponse{}
+			internal.AtMostOneOfValidator{
+				PathExpressions: path.Expressions{
+					path.MatchRelative().AtParent().AtName("write_config"),
+				},
+			}.ValidateList(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
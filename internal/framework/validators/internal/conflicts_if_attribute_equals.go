@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+var (
+	_ validator.List   = ConflictsIfAttributeEqualsValidator{}
+	_ validator.Object = ConflictsIfAttributeEqualsValidator{}
+	_ validator.Set    = ConflictsIfAttributeEqualsValidator{}
+	_ validator.String = ConflictsIfAttributeEqualsValidator{}
+)
+
+type ConflictsIfAttributeEqualsValidator struct {
+	PathExpression path.Expression
+	Value          attr.Value
+}
+
+type ConflictsIfAttributeEqualsValidatorRequest struct {
+	Config         tfsdk.Config
+	ConfigValue    attr.Value
+	Path           path.Path
+	PathExpression path.Expression
+}
+
+type ConflictsIfAttributeEqualsValidatorResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+func (av ConflictsIfAttributeEqualsValidator) Description(ctx context.Context) string {
+	return av.MarkdownDescription(ctx)
+}
+
+func (av ConflictsIfAttributeEqualsValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Ensure that this attribute is not set when %q is %s", av.PathExpression, av.Value)
+}
+
+func (av ConflictsIfAttributeEqualsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateReq := ConflictsIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp ConflictsIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av ConflictsIfAttributeEqualsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	validateReq := ConflictsIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp ConflictsIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av ConflictsIfAttributeEqualsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateReq := ConflictsIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp ConflictsIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av ConflictsIfAttributeEqualsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateReq := ConflictsIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp ConflictsIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av ConflictsIfAttributeEqualsValidator) Validate(ctx context.Context, req ConflictsIfAttributeEqualsValidatorRequest, res *ConflictsIfAttributeEqualsValidatorResponse) {
+	// If current attribute is unknown or not set, there is nothing to check.
+	if req.ConfigValue.IsUnknown() || isNullOrEmpty(ctx, req.ConfigValue) {
+		return
+	}
+
+	matchedPaths, diags := matchingPathValues(ctx, req.Config, req.PathExpression.Merge(av.PathExpression), req.Path)
+	res.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, mp := range matchedPaths {
+		// Delay validation until the attribute has a known value.
+		if mp.value.IsUnknown() {
+			continue
+		}
+
+		equal, diags := valuesEqual(ctx, mp.value, av.Value)
+		res.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		if equal {
+			res.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(req.Path, mp.path, valueString(ctx, av.Value)))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/internal"
+)
+
+func TestConflictsIfAttributeEqualsValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	writePath := path.Root("configuration").AtListIndex(0).AtName("write_config")
+	permissionPath := path.Root("configuration").AtListIndex(0).AtName("permission")
+
+	type testCase struct {
+		config              tfsdk.Config
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"not set": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), testBlock(readType, "a"), nil),
+			),
+		},
+		"set, other value": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "WRITE"), nil, testBlock(writeType, "b")),
+			),
+		},
+		"set, unknown": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil, testBlock(writeType, "b")),
+			),
+		},
+		"set, equal": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), testBlock(readType, "a"), testBlock(writeType, "b")),
+			),
+			expectedDiagnostics: diag.Diagnostics{
+				fwdiag.NewAttributeConflictsWhenError(writePath, permissionPath, "READ"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := testListRequest(ctx, t, test.config, writePath)
+			response := validator.ListResponse{}
+			internal.ConflictsIfAttributeEqualsValidator{
+				PathExpression: path.MatchRelative().AtParent().AtName("permission"),
+				Value:          fwtypes.StringEnumValue(awstypes.AclPermissionRead),
+			}.ValidateList(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
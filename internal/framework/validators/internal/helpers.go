@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type pathValue struct {
+	path  path.Path
+	value attr.Value
+}
+
+// matchingPathValues returns the paths, and their values, in the configuration that match the specified expression.
+// The path of the attribute being validated is excluded.
+func matchingPathValues(ctx context.Context, config tfsdk.Config, expression path.Expression, self path.Path) ([]pathValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matchedPaths, d := config.PathMatches(ctx, expression)
+	diags.Append(d...)
+	if d.HasError() {
+		return nil, diags
+	}
+
+	var result []pathValue
+	for _, mp := range matchedPaths {
+		if mp.Equal(self) {
+			continue
+		}
+
+		var mpVal attr.Value
+		d := config.GetAttribute(ctx, mp, &mpVal)
+		diags.Append(d...)
+
+		// Collect all errors
+		if d.HasError() {
+			continue
+		}
+
+		result = append(result, pathValue{path: mp, value: mpVal})
+	}
+
+	return result, diags
+}
+
+// isNullOrEmpty returns whether the specified value is null or is an empty collection.
+// An omitted list or set nested block is represented as an empty, non-null, collection.
+func isNullOrEmpty(ctx context.Context, v attr.Value) bool {
+	if v.IsNull() {
+		return true
+	}
+
+	switch v := v.(type) {
+	case basetypes.ListValuable:
+		if v, d := v.ToListValue(ctx); !d.HasError() {
+			return !v.IsUnknown() && len(v.Elements()) == 0
+		}
+	case basetypes.SetValuable:
+		if v, d := v.ToSetValue(ctx); !d.HasError() {
+			return !v.IsUnknown() && len(v.Elements()) == 0
+		}
+	case basetypes.MapValuable:
+		if v, d := v.ToMapValue(ctx); !d.HasError() {
+			return !v.IsUnknown() && len(v.Elements()) == 0
+		}
+	}
+
+	return false
+}
+
+// valuesEqual returns whether the specified values have the same Terraform representation.
+// Comparing Terraform values allows a custom type value, e.g. a string enum, to be compared to the corresponding base type value.
+func valuesEqual(ctx context.Context, v1, v2 attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tv1, err := v1.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError("Converting value", err.Error())
+		return false, diags
+	}
+
+	tv2, err := v2.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError("Converting value", err.Error())
+		return false, diags
+	}
+
+	return tv1.Equal(tv2), diags
+}
+
+// valueString returns the string representation of the specified value used in diagnostics.
+func valueString(ctx context.Context, v attr.Value) string {
+	if v, ok := v.(basetypes.StringValuable); ok {
+		if v, d := v.ToStringValue(ctx); !d.HasError() {
+			return v.ValueString()
+		}
+	}
+
+	return v.String()
+}
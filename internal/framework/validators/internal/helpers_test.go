@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type configurationModel struct {
+	Permission  fwtypes.StringEnum[awstypes.AclPermission]  `tfsdk:"permission"`
+	ReadConfig  fwtypes.ListNestedObjectValueOf[readModel]  `tfsdk:"read_config"`
+	WriteConfig fwtypes.ListNestedObjectValueOf[writeModel] `tfsdk:"write_config"`
+}
+
+type readModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+type writeModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+var (
+	readType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"prefix": tftypes.String,
+	}}
+	writeType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"prefix": tftypes.String,
+	}}
+	configurationType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"permission":   tftypes.String,
+		"read_config":  tftypes.List{ElementType: readType},
+		"write_config": tftypes.List{ElementType: writeType},
+	}}
+	rootType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"configuration": tftypes.List{ElementType: configurationType},
+	}}
+)
+
+func testSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AclPermission](),
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"read_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[readModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"write_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[writeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testConfiguration returns a configuration block with the specified permission and, if not nil, read and write blocks.
+// A nil block is represented as an empty list, as is the case for an omitted list nested block.
+func testConfiguration(permission tftypes.Value, read, write *tftypes.Value) tftypes.Value {
+	readConfig := []tftypes.Value{}
+	if read != nil {
+		readConfig = append(readConfig, *read)
+	}
+	writeConfig := []tftypes.Value{}
+	if write != nil {
+		writeConfig = append(writeConfig, *write)
+	}
+
+	return tftypes.NewValue(configurationType, map[string]tftypes.Value{
+		"permission":   permission,
+		"read_config":  tftypes.NewValue(tftypes.List{ElementType: readType}, readConfig),
+		"write_config": tftypes.NewValue(tftypes.List{ElementType: writeType}, writeConfig),
+	})
+}
+
+func testBlock(typ tftypes.Type, prefix string) *tftypes.Value {
+	v := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"prefix": tftypes.NewValue(tftypes.String, prefix),
+	})
+	return &v
+}
+
+func testConfig(ctx context.Context, configurations ...tftypes.Value) tfsdk.Config {
+	return tfsdk.Config{
+		Raw: tftypes.NewValue(rootType, map[string]tftypes.Value{
+			"configuration": tftypes.NewValue(tftypes.List{ElementType: configurationType}, configurations),
+		}),
+		Schema: testSchema(ctx),
+	}
+}
+
+// testListRequest returns the request for a list validator applied to the list at the specified path.
+func testListRequest(ctx context.Context, t *testing.T, config tfsdk.Config, p path.Path) validator.ListRequest {
+	t.Helper()
+
+	var v attr.Value
+	if diags := config.GetAttribute(ctx, p, &v); diags.HasError() {
+		t.Fatalf("GetAttribute(%s): %v", p, diags)
+	}
+
+	configValue, diags := v.(basetypes.ListValuable).ToListValue(ctx)
+	if diags.HasError() {
+		t.Fatalf("ToListValue(%s): %v", p, diags)
+	}
+
+	return validator.ListRequest{
+		Config:         config,
+		ConfigValue:    configValue,
+		Path:           p,
+		PathExpression: p.Expression(),
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+var (
+	_ validator.List   = RequiredIfAttributeEqualsValidator{}
+	_ validator.Object = RequiredIfAttributeEqualsValidator{}
+	_ validator.Set    = RequiredIfAttributeEqualsValidator{}
+	_ validator.String = RequiredIfAttributeEqualsValidator{}
+)
+
+type RequiredIfAttributeEqualsValidator struct {
+	PathExpression path.Expression
+	Value          attr.Value
+}
+
+type RequiredIfAttributeEqualsValidatorRequest struct {
+	Config         tfsdk.Config
+	ConfigValue    attr.Value
+	Path           path.Path
+	PathExpression path.Expression
+}
+
+type RequiredIfAttributeEqualsValidatorResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+func (av RequiredIfAttributeEqualsValidator) Description(ctx context.Context) string {
+	return av.MarkdownDescription(ctx)
+}
+
+func (av RequiredIfAttributeEqualsValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Ensure that this attribute is set when %q is %s", av.PathExpression, av.Value)
+}
+
+func (av RequiredIfAttributeEqualsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateReq := RequiredIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp RequiredIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av RequiredIfAttributeEqualsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	validateReq := RequiredIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp RequiredIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av RequiredIfAttributeEqualsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateReq := RequiredIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp RequiredIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av RequiredIfAttributeEqualsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateReq := RequiredIfAttributeEqualsValidatorRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	var validateResp RequiredIfAttributeEqualsValidatorResponse
+
+	av.Validate(ctx, validateReq, &validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
+
+func (av RequiredIfAttributeEqualsValidator) Validate(ctx context.Context, req RequiredIfAttributeEqualsValidatorRequest, res *RequiredIfAttributeEqualsValidatorResponse) {
+	// If current attribute is unknown or set, there is nothing to check.
+	if req.ConfigValue.IsUnknown() || !isNullOrEmpty(ctx, req.ConfigValue) {
+		return
+	}
+
+	matchedPaths, diags := matchingPathValues(ctx, req.Config, req.PathExpression.Merge(av.PathExpression), req.Path)
+	res.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, mp := range matchedPaths {
+		// Delay validation until the attribute has a known value.
+		if mp.value.IsUnknown() {
+			continue
+		}
+
+		equal, diags := valuesEqual(ctx, mp.value, av.Value)
+		res.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+
+		if equal {
+			res.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(req.Path, mp.path, valueString(ctx, av.Value)))
+		}
+	}
+}
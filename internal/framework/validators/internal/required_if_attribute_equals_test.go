@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/internal"
+)
+
+func TestRequiredIfAttributeEqualsValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	readPath := path.Root("configuration").AtListIndex(1).AtName("read_config")
+	permissionPath := path.Root("configuration").AtListIndex(1).AtName("permission")
+
+	type testCase struct {
+		config              tfsdk.Config
+		value               attr.Value
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"set": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "WRITE"), nil, nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), testBlock(readType, "a"), nil),
+			),
+			value: fwtypes.StringEnumValue(awstypes.AclPermissionRead),
+		},
+		"not set, other value": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), nil, nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, "WRITE"), nil, testBlock(writeType, "b")),
+			),
+			value: fwtypes.StringEnumValue(awstypes.AclPermissionRead),
+		},
+		"not set, null": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), nil, nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, nil), nil, nil),
+			),
+			value: fwtypes.StringEnumValue(awstypes.AclPermissionRead),
+		},
+		"not set, unknown": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), nil, nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil, nil),
+			),
+			value: fwtypes.StringEnumValue(awstypes.AclPermissionRead),
+		},
+		"not set, equal": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "WRITE"), nil, nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), nil, nil),
+			),
+			value: fwtypes.StringEnumValue(awstypes.AclPermissionRead),
+			expectedDiagnostics: diag.Diagnostics{
+				fwdiag.NewAttributeRequiredWhenError(readPath, permissionPath, "READ"),
+			},
+		},
+		"not set, equal base type": {
+			config: testConfig(ctx,
+				testConfiguration(tftypes.NewValue(tftypes.String, "WRITE"), nil, nil),
+				testConfiguration(tftypes.NewValue(tftypes.String, "READ"), nil, nil),
+			),
+			value: types.StringValue("READ"),
+			expectedDiagnostics: diag.Diagnostics{
+				fwdiag.NewAttributeRequiredWhenError(readPath, permissionPath, "READ"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := testListRequest(ctx, t, test.config, readPath)
+			response := validator.ListResponse{}
+			internal.RequiredIfAttributeEqualsValidator{
+				PathExpression: path.MatchRelative().AtParent().AtName("permission"),
+				Value:          test.value,
+			}.ValidateList(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/internal"
+)
+
+// AtMostOneOf checks that of a set of path.Expression, including the attribute this validator is applied to,
+// at most one has a value.
+// An empty list or set, i.e. an omitted list or set nested block, is not considered to be set.
+func AtMostOneOf(expressions ...path.Expression) validator.List {
+	return internal.AtMostOneOfValidator{
+		PathExpressions: expressions,
+	}
+}
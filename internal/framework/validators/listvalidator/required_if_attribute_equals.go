@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/internal"
+)
+
+// RequiredIfAttributeEquals checks that a value is set when the attribute at the given path.Expression
+// has the given value.
+// An empty list or set, i.e. an omitted list or set nested block, is not considered to be set.
+func RequiredIfAttributeEquals(expression path.Expression, value attr.Value) validator.List {
+	return internal.RequiredIfAttributeEqualsValidator{
+		PathExpression: expression,
+		Value:          value,
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators/internal"
+)
+
+// ConflictsIfAttributeEquals checks that a value is not set when the attribute at the given path.Expression
+// has the given value.
+// An empty list or set, i.e. an omitted list or set nested block, is not considered to be set.
+func ConflictsIfAttributeEquals(expression path.Expression, value attr.Value) validator.Set {
+	return internal.ConflictsIfAttributeEqualsValidator{
+		PathExpression: expression,
+		Value:          value,
+	}
+}
@@ -0,0 +1,53 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# Approximate cost, in USD, of one hour of acceptance testing for a service.
+# These are rough estimates of the resources a typical test creates and are only used
+# to estimate and budget the cost of shards. Add a service entry if its tests create
+# resources that are noticeably more expensive than the default.
+
+default_cost_per_hour = 0.10
+
+service "docdb" {
+  cost_per_hour = 1.00
+}
+
+service "ec2" {
+  cost_per_hour = 0.25
+}
+
+service "eks" {
+  cost_per_hour = 0.50
+}
+
+service "elasticache" {
+  cost_per_hour = 0.50
+}
+
+service "fsx" {
+  cost_per_hour = 1.00
+}
+
+service "memorydb" {
+  cost_per_hour = 0.75
+}
+
+service "neptune" {
+  cost_per_hour = 1.00
+}
+
+service "opensearch" {
+  cost_per_hour = 0.75
+}
+
+service "rds" {
+  cost_per_hour = 1.00
+}
+
+service "redshift" {
+  cost_per_hour = 1.00
+}
+
+service "sagemaker" {
+  cost_per_hour = 0.50
+}
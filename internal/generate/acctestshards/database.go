@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	modulePath = "github.com/hashicorp/terraform-provider-aws/"
+
+	// durationWindow is the number of runs over which test durations are averaged.
+	// Older runs decay out of the average so that the database follows changes in test behavior.
+	durationWindow = 5
+)
+
+// database records the historical duration of each acceptance test.
+type database struct {
+	Tests []testRecord `json:"tests"`
+
+	index map[string]int // Package and test name -> index in Tests.
+}
+
+type testRecord struct {
+	Package         string    `json:"package"`
+	Name            string    `json:"name"`
+	Runs            int       `json:"runs"`
+	Failures        int       `json:"failures"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	LastRun         time.Time `json:"last_run"`
+}
+
+func (r testRecord) Duration() time.Duration {
+	return time.Duration(r.DurationSeconds * float64(time.Second))
+}
+
+func readDatabase(filename string) (*database, error) {
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return &database{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var db database
+
+	if err := json.Unmarshal(b, &db); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	return &db, nil
+}
+
+func (db *database) write(filename string) error {
+	slices.SortFunc(db.Tests, func(a, b testRecord) int {
+		return cmp.Or(cmp.Compare(a.Package, b.Package), cmp.Compare(a.Name, b.Name))
+	})
+	db.index = nil
+
+	b, err := json.MarshalIndent(db, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(b, '\n'), 0644) //nolint:mnd // good protection for new files
+}
+
+// lookup returns the record for a test.
+func (db *database) lookup(pkg, name string) (testRecord, bool) {
+	i, ok := db.indexOf(pkg, name)
+	if !ok {
+		return testRecord{}, false
+	}
+	return db.Tests[i], true
+}
+
+func (db *database) indexOf(pkg, name string) (int, bool) {
+	if db.index == nil {
+		db.index = make(map[string]int, len(db.Tests))
+		for i, r := range db.Tests {
+			db.index[r.Package+"."+r.Name] = i
+		}
+	}
+
+	i, ok := db.index[pkg+"."+name]
+	return i, ok
+}
+
+// record adds a test result to the database, averaging its duration with that of previous runs.
+func (db *database) record(pkg, name string, elapsed float64, failed bool, at time.Time) {
+	i, ok := db.indexOf(pkg, name)
+	if !ok {
+		db.Tests = append(db.Tests, testRecord{
+			Package: pkg,
+			Name:    name,
+		})
+		i = len(db.Tests) - 1
+		db.index[pkg+"."+name] = i
+	}
+
+	r := &db.Tests[i]
+	r.Runs++
+	if failed {
+		r.Failures++
+	}
+	r.DurationSeconds += (elapsed - r.DurationSeconds) / float64(min(r.Runs, durationWindow))
+	if at.After(r.LastRun) {
+		r.LastRun = at
+	}
+}
+
+// testEvent is an event emitted by `go test -json`, see `go doc test2json`.
+type testEvent struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+}
+
+// ingest records the results of top-level tests from `go test -json` output.
+// Skipped tests and subtests are ignored; a serial test's duration includes that of its subtests.
+func (db *database) ingest(r io.Reader) (int, error) {
+	n := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) //nolint:mnd // test output lines can be long
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		// `go test -json` output may be interleaved with non-JSON build output.
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+
+		var event testEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			continue
+		}
+
+		if event.Test == "" || strings.Contains(event.Test, "/") {
+			continue
+		}
+
+		switch event.Action {
+		case "pass", "fail":
+			db.record(strings.TrimPrefix(event.Package, modulePath), event.Test, event.Elapsed, event.Action == "fail", event.Time)
+			n++
+		}
+	}
+
+	return n, scanner.Err()
+}
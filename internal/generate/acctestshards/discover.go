@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// acctest is an acceptance test together with the requirements that determine where it can be run.
+type acctest struct {
+	Package string // e.g. internal/service/rds.
+	Name    string
+
+	// Semaphores are the names of `experimental/sync` semaphores used by the test.
+	// Tests sharing a semaphore must run in the same shard for the semaphore's limit to hold.
+	Semaphores []string
+
+	// Partitions and Regions are the values passed to `acctest.PreCheckPartition` and `acctest.PreCheckRegion`,
+	// and ExcludedPartitions and ExcludedRegions those passed to `acctest.PreCheckPartitionNot` and `acctest.PreCheckRegionNot`.
+	Partitions         []string
+	ExcludedPartitions []string
+	Regions            []string
+	ExcludedRegions    []string
+
+	AlternateAccount bool // acctest.PreCheckAlternateAccount, PreCheckThirdAccount or PreCheckFourthAccount.
+	MultipleRegions  int  // acctest.PreCheckMultipleRegion.
+}
+
+// requirements accumulates the requirements found in a function body.
+type requirements struct {
+	semaphores         []string
+	partitions         []string
+	excludedPartitions []string
+	regions            []string
+	excludedRegions    []string
+	alternateAccount   bool
+	multipleRegions    int
+}
+
+func (r *requirements) merge(o requirements) {
+	r.semaphores = appendUnique(r.semaphores, o.semaphores...)
+	r.partitions = appendUnique(r.partitions, o.partitions...)
+	r.excludedPartitions = appendUnique(r.excludedPartitions, o.excludedPartitions...)
+	r.regions = appendUnique(r.regions, o.regions...)
+	r.excludedRegions = appendUnique(r.excludedRegions, o.excludedRegions...)
+	r.alternateAccount = r.alternateAccount || o.alternateAccount
+	r.multipleRegions = max(r.multipleRegions, o.multipleRegions)
+}
+
+// discoverTests parses a service package's test files and returns its top-level acceptance tests.
+// Requirements are collected from the test function and, transitively, from the package's functions it references,
+// so that those of serial tests' subtests and service-specific PreCheck functions are included.
+func discoverTests(dir, pkg string) ([]acctest, []string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fset := token.NewFileSet()
+	funcs := make(map[string]*ast.FuncDecl)
+	var names []string
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Body == nil {
+				continue
+			}
+
+			funcs[fn.Name.Name] = fn
+
+			if strings.HasPrefix(fn.Name.Name, "TestAcc") && fn.Type.Params.NumFields() == 1 {
+				names = append(names, fn.Name.Name)
+			}
+		}
+	}
+
+	v := &visitor{
+		funcs: funcs,
+		cache: make(map[string]requirements),
+	}
+
+	var tests []acctest
+	for _, name := range names {
+		r := v.requirements(name, nil)
+
+		tests = append(tests, acctest{
+			Package:            pkg,
+			Name:               name,
+			Semaphores:         r.semaphores,
+			Partitions:         r.partitions,
+			ExcludedPartitions: r.excludedPartitions,
+			Regions:            r.regions,
+			ExcludedRegions:    r.excludedRegions,
+			AlternateAccount:   r.alternateAccount,
+			MultipleRegions:    r.multipleRegions,
+		})
+	}
+	slices.SortFunc(tests, func(a, b acctest) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return tests, v.unresolved, nil
+}
+
+type visitor struct {
+	funcs      map[string]*ast.FuncDecl
+	cache      map[string]requirements
+	unresolved []string
+}
+
+func (v *visitor) requirements(name string, stack []string) requirements {
+	if r, ok := v.cache[name]; ok {
+		return r
+	}
+
+	fn, ok := v.funcs[name]
+	if !ok || slices.Contains(stack, name) {
+		return requirements{}
+	}
+	stack = append(stack, name)
+
+	var r requirements
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			v.call(n, &r)
+		case *ast.Ident:
+			if n.Name != name {
+				if _, ok := v.funcs[n.Name]; ok {
+					r.merge(v.requirements(n.Name, stack))
+				}
+			}
+		}
+		return true
+	})
+
+	v.cache[name] = r
+
+	return r
+}
+
+func (v *visitor) call(call *ast.CallExpr, r *requirements) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	switch sel.Sel.Name {
+	case "GetSemaphore":
+		if len(call.Args) > 0 {
+			if s, ok := v.stringValue(call.Args[0]); ok {
+				r.semaphores = appendUnique(r.semaphores, s)
+			}
+		}
+		return
+	}
+
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "acctest" {
+		return
+	}
+
+	// The first argument is always `t`.
+	args := call.Args
+	if len(args) > 0 {
+		args = args[1:]
+	}
+
+	switch sel.Sel.Name {
+	case "PreCheckPartition":
+		r.partitions = appendUnique(r.partitions, v.stringValues(args)...)
+	case "PreCheckPartitionNot":
+		r.excludedPartitions = appendUnique(r.excludedPartitions, v.stringValues(args)...)
+	case "PreCheckRegion":
+		r.regions = appendUnique(r.regions, v.stringValues(args)...)
+	case "PreCheckRegionNot":
+		r.excludedRegions = appendUnique(r.excludedRegions, v.stringValues(args)...)
+	case "PreCheckAlternateAccount", "PreCheckThirdAccount", "PreCheckFourthAccount":
+		r.alternateAccount = true
+	case "PreCheckMultipleRegion":
+		if len(args) > 0 {
+			if lit, ok := args[0].(*ast.BasicLit); ok && lit.Kind == token.INT {
+				if n, err := strconv.Atoi(lit.Value); err == nil {
+					r.multipleRegions = max(r.multipleRegions, n)
+				}
+			}
+		}
+	}
+}
+
+var (
+	// e.g. endpoints.UsGovWest1RegionID, endpoints.AwsUsGovPartitionID, types.RegionNameUsEast1.
+	endpointsIDRegexp = regexp.MustCompile(`^(?:RegionName)?([A-Z][A-Za-z0-9]*?)(?:RegionID|PartitionID)?$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+	wordRegexp        = regexp.MustCompile(`[A-Z][a-z]*|[0-9]+`)                                             // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+func (v *visitor) stringValues(exprs []ast.Expr) []string {
+	var values []string
+	for _, expr := range exprs {
+		if s, ok := v.stringValue(expr); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// stringValue statically evaluates a region or partition argument.
+// String literals and the region and partition identifier constants of the AWS SDKs are supported.
+func (v *visitor) stringValue(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind == token.STRING {
+			if s, err := strconv.Unquote(expr.Value); err == nil {
+				return s, true
+			}
+		}
+	case *ast.CallExpr:
+		// e.g. string(types.RegionNameUsEast1).
+		if ident, ok := expr.Fun.(*ast.Ident); ok && ident.Name == "string" && len(expr.Args) == 1 {
+			return v.stringValue(expr.Args[0])
+		}
+	case *ast.SelectorExpr:
+		name := expr.Sel.Name
+		if m := endpointsIDRegexp.FindStringSubmatch(name); m != nil && name != m[1] {
+			return strings.ToLower(strings.Join(wordRegexp.FindAllString(m[1], -1), "-")), true
+		}
+	}
+
+	if !slices.Contains(v.unresolved, exprString(expr)) {
+		v.unresolved = append(v.unresolved, exprString(expr))
+	}
+
+	return "", false
+}
+
+// exprString returns a short textual representation of an expression for diagnostics.
+func exprString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return exprString(expr.X) + "." + expr.Sel.Name
+	default:
+		return "<expression>"
+	}
+}
+
+func appendUnique(s []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+const (
+	// minEstimateSamples is the number of recorded durations a package needs for them to be used to estimate
+	// the durations of its other tests.
+	minEstimateSamples = 5
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tacctestshards ingest [flags] <go-test-json-file>...\n")
+	fmt.Fprintf(os.Stderr, "\tacctestshards plan [flags] [<service-package>...]\n\n")
+	fmt.Fprintf(os.Stderr, "Run `acctestshards <command> -h` for the flags of each command.\n")
+}
+
+// acctestshards schedules acceptance tests into balanced shards.
+//
+// The `ingest` command records the durations of the tests in `go test -json` output in a database.
+// The `plan` command packs the acceptance tests of the service packages into shards using the recorded durations,
+// writing a manifest for each shard that is run using `make testacc SHARD=<manifest>`.
+// Run from the repository root, e.g. `make testacc-shards-ingest` and `make testacc-shards`.
+func main() {
+	g := common.NewGenerator()
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "ingest":
+		ingest(g, args)
+	case "plan":
+		plan(g, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func ingest(g *common.Generator, args []string) {
+	fs := flag.NewFlagSet("ingest", flag.ExitOnError)
+	databaseFile := fs.String("Database", ".ci/acctest-durations.json", "path to test duration database")
+	fs.Parse(args)
+
+	db, err := readDatabase(*databaseFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", *databaseFile, err)
+	}
+
+	for _, filename := range fs.Args() {
+		f, err := os.Open(filename)
+
+		if err != nil {
+			g.Fatalf("error opening %s: %s", filename, err)
+		}
+
+		n, err := db.ingest(f)
+		f.Close()
+
+		if err != nil {
+			g.Fatalf("error reading %s: %s", filename, err)
+		}
+
+		g.Infof("Recorded %d test results from %s", n, filename)
+	}
+
+	if err := db.write(*databaseFile); err != nil {
+		g.Fatalf("error writing %s: %s", *databaseFile, err)
+	}
+}
+
+func plan(g *common.Generator, args []string) {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+	var (
+		databaseFile       = fs.String("Database", ".ci/acctest-durations.json", "path to test duration database")
+		costFile           = fs.String("CostConfig", "internal/generate/acctestshards/acctest_costs.hcl", "path to service cost configuration")
+		serviceFile        = fs.String("ServiceConfig", "internal/generate/teamcity/acctest_services.hcl", "path to TeamCity service configuration, used for service regions and skipped services")
+		servicePackageRoot = fs.String("ServicePackageRoot", "internal/service", "path to service package root directory")
+		output             = fs.String("Output", "shards", "directory to write shard manifests to")
+		count              = fs.Int("Shards", 4, "number of shards")
+		maxDuration        = fs.Duration("MaxShardDuration", 0, "maximum estimated test time per shard; overrides -Shards")
+		defaultDuration    = fs.Duration("DefaultDuration", 5*time.Minute, "estimated duration of tests without recorded durations in their package")
+		budget             = fs.Float64("Budget", 0, "maximum estimated cost of all shards, in USD; 0 for no limit")
+		regions            = fs.String("Regions", "us-west-2,us-east-1,us-east-2", "comma-separated commercial regions, in order of preference")
+	)
+	fs.Parse(args)
+
+	db, err := readDatabase(*databaseFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", *databaseFile, err)
+	}
+
+	var costs costConfig
+
+	if err := hclsimple.DecodeFile(*costFile, nil, &costs); err != nil {
+		g.Fatalf("error reading %s: %s", *costFile, err)
+	}
+
+	var services serviceConfig
+
+	if err := hclsimple.DecodeFile(*serviceFile, nil, &services); err != nil {
+		g.Fatalf("error reading %s: %s", *serviceFile, err)
+	}
+
+	packages := fs.Args()
+	if len(packages) == 0 {
+		entries, err := os.ReadDir(*servicePackageRoot)
+
+		if err != nil {
+			g.Fatalf("error reading %s: %s", *servicePackageRoot, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() {
+				packages = append(packages, entry.Name())
+			}
+		}
+	}
+
+	var (
+		tests        []scheduledTest
+		environments = make(map[string]environment)
+	)
+
+	for _, p := range packages {
+		service := services.service(p)
+		if service.Skip {
+			g.Infof("Skipping service %q...", p)
+			continue
+		}
+
+		dir := path.Join(*servicePackageRoot, p)
+		acctests, unresolved, err := discoverTests(dir, filepath.ToSlash(dir))
+
+		if err != nil {
+			g.Fatalf("error parsing %s: %s", dir, err)
+		}
+
+		for _, v := range unresolved {
+			g.Warnf("%s: cannot determine value of PreCheck or semaphore argument %s", dir, v)
+		}
+
+		// Tests without recorded durations are estimated from the median of their package's recorded durations,
+		// provided that there are enough of them for the median to be meaningful.
+		var recorded []time.Duration
+		for _, t := range acctests {
+			if r, ok := db.lookup(t.Package, t.Name); ok {
+				recorded = append(recorded, r.Duration())
+			}
+		}
+		estimate := *defaultDuration
+		if len(recorded) >= minEstimateSamples {
+			slices.Sort(recorded)
+			estimate = recorded[len(recorded)/2]
+		}
+
+		for _, t := range acctests {
+			st := scheduledTest{
+				acctest:  t,
+				Duration: estimate,
+			}
+			if r, ok := db.lookup(t.Package, t.Name); ok {
+				st.Duration = r.Duration()
+			} else {
+				st.Estimated = true
+			}
+			st.Cost = st.Duration.Hours() * costs.costPerHour(serviceName(t.Package))
+
+			environments[testKey(t)] = environmentFor(t, service.Region, strings.Split(*regions, ","))
+			tests = append(tests, st)
+		}
+	}
+
+	if len(tests) == 0 {
+		g.Fatalf("no acceptance tests found")
+	}
+
+	var total float64
+	for _, t := range tests {
+		total += t.Cost
+	}
+
+	if *budget > 0 && total > *budget {
+		g.Fatalf("estimated cost $%.2f exceeds budget of $%.2f", total, *budget)
+	}
+
+	us, moved := units(tests, environments)
+
+	for _, v := range moved {
+		g.Warnf("%s shares a semaphore with tests in another environment; some of its subtests may be skipped", v)
+	}
+
+	shards, err := pack(us, *count, *maxDuration)
+
+	if err != nil {
+		g.Fatalf("error packing shards: %s", err)
+	}
+
+	if err := os.MkdirAll(*output, 0755); err != nil { //nolint:mnd // good protection for new directories
+		g.Fatalf("error creating %s: %s", *output, err)
+	}
+
+	for _, s := range shards {
+		filename := filepath.Join(*output, s.filename())
+
+		if err := os.WriteFile(filename, []byte(s.manifest()), 0644); err != nil { //nolint:mnd // good protection for new files
+			g.Fatalf("error writing %s: %s", filename, err)
+		}
+
+		g.Infof("%s: %s, %d tests, estimated test time %s, estimated cost $%.2f", filename, s.Environment, len(s.Tests), s.Duration.Round(time.Second), s.Cost)
+	}
+
+	g.Infof("Estimated total cost $%.2f", total)
+}
+
+type costConfig struct {
+	DefaultCostPerHour float64             `hcl:"default_cost_per_hour"`
+	Services           []serviceCostConfig `hcl:"service,block"`
+}
+
+type serviceCostConfig struct {
+	Service     string  `hcl:",label"`
+	CostPerHour float64 `hcl:"cost_per_hour"`
+}
+
+func (c costConfig) costPerHour(service string) float64 {
+	for _, v := range c.Services {
+		if v.Service == service {
+			return v.CostPerHour
+		}
+	}
+	return c.DefaultCostPerHour
+}
+
+// serviceConfig is the subset of the TeamCity service configuration used for scheduling.
+type serviceConfig struct {
+	Services []serviceScheduleConfig `hcl:"service,block"`
+}
+
+type serviceScheduleConfig struct {
+	Service string   `hcl:",label"`
+	Region  string   `hcl:"region,optional"`
+	Skip    bool     `hcl:"skip,optional"`
+	Remain  hcl.Body `hcl:",remain"`
+}
+
+func (c serviceConfig) service(service string) serviceScheduleConfig {
+	for _, v := range c.Services {
+		if v.Service == service {
+			return v
+		}
+	}
+	return serviceScheduleConfig{}
+}
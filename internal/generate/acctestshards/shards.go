@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// environment is the partition and region in which a shard's tests are run.
+type environment struct {
+	Partition string
+	Region    string
+}
+
+func (e environment) String() string {
+	return e.Partition + "/" + e.Region
+}
+
+var defaultPartitionRegions = map[string]string{
+	"aws":        "us-west-2",
+	"aws-cn":     "cn-north-1",
+	"aws-us-gov": "us-gov-west-1",
+}
+
+// environmentFor returns the environment in which a test's PreCheck requirements are satisfied.
+// Preference is given to the service's configured region, then to the partition's default region,
+// then to the regions in the order given.
+// The requirements of a serial test are those of all its subtests, so it is placed in an environment in which at least
+// some of its region-specific subtests run; the others skip as they would in any unsharded run.
+func environmentFor(t acctest, serviceRegion string, regions []string) environment {
+	partition := "aws"
+	switch {
+	case len(t.Partitions) > 0:
+		partition = t.Partitions[0]
+	case slices.Contains(t.ExcludedPartitions, partition):
+		for _, p := range slices.Sorted(maps.Keys(defaultPartitionRegions)) {
+			if !slices.Contains(t.ExcludedPartitions, p) {
+				partition = p
+				break
+			}
+		}
+	}
+
+	candidates := []string{serviceRegion, defaultPartitionRegions[partition]}
+	if partition == "aws" {
+		candidates = append(candidates, regions...)
+	}
+	candidates = append(candidates, t.Regions...)
+
+	for _, region := range candidates {
+		if region == "" || slices.Contains(t.ExcludedRegions, region) {
+			continue
+		}
+		if len(t.Regions) > 0 && !slices.Contains(t.Regions, region) {
+			continue
+		}
+
+		return environment{
+			Partition: partition,
+			Region:    region,
+		}
+	}
+
+	return environment{
+		Partition: partition,
+		Region:    defaultPartitionRegions[partition],
+	}
+}
+
+// unit is a set of tests that must run in the same shard.
+type unit struct {
+	Environment environment
+	Tests       []scheduledTest
+	Duration    time.Duration
+	Cost        float64
+}
+
+type scheduledTest struct {
+	acctest
+	Duration  time.Duration
+	Cost      float64
+	Estimated bool // No duration was recorded for the test.
+}
+
+// units groups tests sharing a semaphore into single scheduling units.
+// A unit is run in the environment of its first test; the names of tests for which that is not their own environment are also returned.
+func units(tests []scheduledTest, environments map[string]environment) ([]*unit, []string) {
+	var (
+		result      []*unit
+		moved       []string
+		bySemaphore = make(map[string]*unit)
+	)
+
+	for _, t := range tests {
+		var u *unit
+		for _, s := range t.Semaphores {
+			if v, ok := bySemaphore[s]; ok {
+				u = v
+				break
+			}
+		}
+
+		if u == nil {
+			u = &unit{
+				Environment: environments[testKey(t.acctest)],
+			}
+			result = append(result, u)
+		}
+
+		for _, s := range t.Semaphores {
+			bySemaphore[s] = u
+		}
+
+		if e := environments[testKey(t.acctest)]; e != u.Environment {
+			moved = append(moved, fmt.Sprintf("%s (%s)", testKey(t.acctest), e))
+		}
+
+		u.Tests = append(u.Tests, t)
+		u.Duration += t.Duration
+		u.Cost += t.Cost
+	}
+
+	return result, moved
+}
+
+type shard struct {
+	Index       int
+	Count       int
+	Environment environment
+	Tests       []scheduledTest
+	Duration    time.Duration
+	Cost        float64
+}
+
+// pack distributes units across shards. Each environment receives at least one shard and the remainder are
+// allocated in proportion to the environments' total test time. If maxDuration is set, it determines the number
+// of shards for each environment instead.
+// Within an environment, units are assigned longest first to the least-loaded shard.
+func pack(units []*unit, count int, maxDuration time.Duration) ([]*shard, error) {
+	byEnvironment := make(map[environment][]*unit)
+	load := make(map[environment]time.Duration)
+	for _, u := range units {
+		byEnvironment[u.Environment] = append(byEnvironment[u.Environment], u)
+		load[u.Environment] += u.Duration
+	}
+
+	environments := slices.SortedFunc(maps.Keys(byEnvironment), func(a, b environment) int {
+		return cmp.Or(cmp.Compare(a.Partition, b.Partition), cmp.Compare(a.Region, b.Region))
+	})
+
+	allocation := make(map[environment]int)
+	if maxDuration > 0 {
+		for _, e := range environments {
+			allocation[e] = max(1, int(math.Ceil(float64(load[e])/float64(maxDuration))))
+		}
+	} else {
+		if count < len(environments) {
+			return nil, fmt.Errorf("%d shards requested, but tests require %d environments (%s)", count, len(environments), strings.Join(tfslices.ApplyToAll(environments, environment.String), ", "))
+		}
+
+		for _, e := range environments {
+			allocation[e] = 1
+		}
+		for range count - len(environments) {
+			e := slices.MaxFunc(environments, func(a, b environment) int {
+				return cmp.Compare(float64(load[a])/float64(allocation[a]), float64(load[b])/float64(allocation[b]))
+			})
+			allocation[e]++
+		}
+	}
+
+	var shards []*shard
+	for _, e := range environments {
+		envShards := make([]*shard, allocation[e])
+		for i := range envShards {
+			envShards[i] = &shard{
+				Environment: e,
+			}
+		}
+
+		us := byEnvironment[e]
+		slices.SortStableFunc(us, func(a, b *unit) int {
+			return cmp.Compare(b.Duration, a.Duration)
+		})
+
+		for _, u := range us {
+			s := slices.MinFunc(envShards, func(a, b *shard) int {
+				return cmp.Compare(a.Duration, b.Duration)
+			})
+			s.Tests = append(s.Tests, u.Tests...)
+			s.Duration += u.Duration
+			s.Cost += u.Cost
+		}
+
+		for _, s := range envShards {
+			if len(s.Tests) > 0 {
+				shards = append(shards, s)
+			}
+		}
+	}
+
+	for i, s := range shards {
+		s.Index = i + 1
+		s.Count = len(shards)
+		slices.SortFunc(s.Tests, func(a, b scheduledTest) int {
+			return cmp.Or(cmp.Compare(a.Package, b.Package), cmp.Compare(a.Name, b.Name))
+		})
+	}
+
+	return shards, nil
+}
+
+// manifest renders a shard as a Makefile fragment which the `testacc` target includes when `SHARD` is set.
+func (s *shard) manifest() string {
+	var (
+		packages  []string
+		names     []string
+		notes     []string
+		estimated int
+		alternate bool
+		regions   int
+	)
+	for _, t := range s.Tests {
+		if p := "./" + t.Package; !slices.Contains(packages, p) {
+			packages = append(packages, p)
+		}
+		names = append(names, t.Name)
+		if t.Estimated {
+			estimated++
+		}
+		alternate = alternate || t.AlternateAccount
+		regions = max(regions, t.MultipleRegions)
+	}
+	if alternate {
+		notes = append(notes, "an alternate account")
+	}
+	if regions > 1 {
+		notes = append(notes, fmt.Sprintf("%d regions", regions))
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Code generated by internal/generate/acctestshards/main.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&sb, "# Shard %d of %d: partition %s, region %s\n", s.Index, s.Count, s.Environment.Partition, s.Environment.Region)
+	fmt.Fprintf(&sb, "# %d tests (%d without recorded durations), estimated test time %s, estimated cost $%.2f\n", len(s.Tests), estimated, s.Duration.Round(time.Second), s.Cost)
+	if len(notes) > 0 {
+		fmt.Fprintf(&sb, "# Requires %s\n", strings.Join(notes, " and "))
+	}
+	fmt.Fprintf(&sb, "\n")
+	fmt.Fprintf(&sb, "SHARD_PARTITION = %s\n", s.Environment.Partition)
+	fmt.Fprintf(&sb, "SHARD_REGION = %s\n", s.Environment.Region)
+	fmt.Fprintf(&sb, "SHARD_PACKAGES = %s\n", strings.Join(packages, " "))
+	// `$` must be escaped in Makefiles.
+	fmt.Fprintf(&sb, "SHARD_TESTS = ^(%s)$$\n", strings.Join(names, "|"))
+
+	return sb.String()
+}
+
+func (s *shard) filename() string {
+	return fmt.Sprintf("shard-%02d.mk", s.Index)
+}
+
+// serviceName returns the service package name of a test package, e.g. rds for internal/service/rds.
+func serviceName(pkg string) string {
+	return path.Base(pkg)
+}
+
+func testKey(t acctest) string {
+	return t.Package + "." + t.Name
+}
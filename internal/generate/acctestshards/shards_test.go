@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	usWest2 = environment{Partition: "aws", Region: "us-west-2"}
+	usEast1 = environment{Partition: "aws", Region: "us-east-1"}
+)
+
+func TestEnvironmentFor(t *testing.T) {
+	t.Parallel()
+
+	regions := []string{"us-west-2", "us-east-1", "us-east-2"}
+
+	testCases := map[string]struct {
+		test          acctest
+		serviceRegion string
+		expected      environment
+	}{
+		"no requirements": {
+			expected: usWest2,
+		},
+		"service region": {
+			serviceRegion: "us-east-1",
+			expected:      usEast1,
+		},
+		"required region": {
+			test:     acctest{Regions: []string{"us-east-2"}},
+			expected: environment{Partition: "aws", Region: "us-east-2"},
+		},
+		"required region overrides service region": {
+			test:          acctest{Regions: []string{"us-west-2"}},
+			serviceRegion: "us-east-1",
+			expected:      usWest2,
+		},
+		"excluded region": {
+			test:     acctest{ExcludedRegions: []string{"us-west-2"}},
+			expected: usEast1,
+		},
+		"required partition": {
+			test:     acctest{Partitions: []string{"aws-us-gov"}},
+			expected: environment{Partition: "aws-us-gov", Region: "us-gov-west-1"},
+		},
+		"excluded partition": {
+			test:     acctest{ExcludedPartitions: []string{"aws"}},
+			expected: environment{Partition: "aws-cn", Region: "cn-north-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := environmentFor(testCase.test, testCase.serviceRegion, regions)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnits(t *testing.T) {
+	t.Parallel()
+
+	tests := []scheduledTest{
+		testScheduledTest("TestAccA", 10*time.Minute, "lock"),
+		testScheduledTest("TestAccB", 20*time.Minute),
+		testScheduledTest("TestAccC", 30*time.Minute, "lock"),
+	}
+	environments := map[string]environment{
+		"internal/service/a.TestAccA": usWest2,
+		"internal/service/a.TestAccB": usWest2,
+		"internal/service/a.TestAccC": usEast1,
+	}
+
+	us, moved := units(tests, environments)
+
+	if got, want := len(us), 2; got != want {
+		t.Fatalf("number of units = %v, want %v", got, want)
+	}
+	if got, want := testNames(us[0].Tests), []string{"TestAccA", "TestAccC"}; !cmp.Equal(got, want) {
+		t.Errorf("units[0] tests = %v, want %v", got, want)
+	}
+	if got, want := us[0].Environment, usWest2; got != want {
+		t.Errorf("units[0] environment = %v, want %v", got, want)
+	}
+	if got, want := us[0].Duration, 40*time.Minute; got != want {
+		t.Errorf("units[0] duration = %v, want %v", got, want)
+	}
+	if got, want := moved, []string{"internal/service/a.TestAccC (aws/us-east-1)"}; !cmp.Equal(got, want) {
+		t.Errorf("moved = %v, want %v", got, want)
+	}
+}
+
+func TestPack(t *testing.T) {
+	t.Parallel()
+
+	type expectedShard struct {
+		Environment environment
+		Tests       []string
+		Duration    time.Duration
+	}
+
+	testCases := map[string]struct {
+		units       []*unit
+		count       int
+		maxDuration time.Duration
+		expected    []expectedShard
+		expectError bool
+	}{
+		"longest first into least-loaded shard": {
+			units: []*unit{
+				testUnit(usWest2, "TestAccA", 10*time.Minute),
+				testUnit(usWest2, "TestAccB", 20*time.Minute),
+				testUnit(usWest2, "TestAccC", 30*time.Minute),
+				testUnit(usWest2, "TestAccD", 40*time.Minute),
+				testUnit(usWest2, "TestAccE", 50*time.Minute),
+				testUnit(usWest2, "TestAccF", 60*time.Minute),
+			},
+			count: 2,
+			expected: []expectedShard{
+				{Environment: usWest2, Tests: []string{"TestAccB", "TestAccC", "TestAccF"}, Duration: 110 * time.Minute},
+				{Environment: usWest2, Tests: []string{"TestAccA", "TestAccD", "TestAccE"}, Duration: 100 * time.Minute},
+			},
+		},
+		"shards allocated in proportion to environment load": {
+			units: []*unit{
+				testUnit(usWest2, "TestAccA", 100*time.Minute),
+				testUnit(usWest2, "TestAccB", 100*time.Minute),
+				testUnit(usWest2, "TestAccC", 100*time.Minute),
+				testUnit(usEast1, "TestAccD", 100*time.Minute),
+			},
+			count: 4,
+			expected: []expectedShard{
+				{Environment: usEast1, Tests: []string{"TestAccD"}, Duration: 100 * time.Minute},
+				{Environment: usWest2, Tests: []string{"TestAccA"}, Duration: 100 * time.Minute},
+				{Environment: usWest2, Tests: []string{"TestAccB"}, Duration: 100 * time.Minute},
+				{Environment: usWest2, Tests: []string{"TestAccC"}, Duration: 100 * time.Minute},
+			},
+		},
+		"max duration": {
+			units: []*unit{
+				testUnit(usWest2, "TestAccA", 90*time.Minute),
+				testUnit(usWest2, "TestAccB", 60*time.Minute),
+				testUnit(usWest2, "TestAccC", 30*time.Minute),
+			},
+			count:       1,
+			maxDuration: 100 * time.Minute,
+			expected: []expectedShard{
+				{Environment: usWest2, Tests: []string{"TestAccA"}, Duration: 90 * time.Minute},
+				{Environment: usWest2, Tests: []string{"TestAccB", "TestAccC"}, Duration: 90 * time.Minute},
+			},
+		},
+		"empty shards omitted": {
+			units: []*unit{
+				testUnit(usWest2, "TestAccA", 10*time.Minute),
+			},
+			count: 3,
+			expected: []expectedShard{
+				{Environment: usWest2, Tests: []string{"TestAccA"}, Duration: 10 * time.Minute},
+			},
+		},
+		"too few shards for environments": {
+			units: []*unit{
+				testUnit(usWest2, "TestAccA", 10*time.Minute),
+				testUnit(usEast1, "TestAccB", 10*time.Minute),
+			},
+			count:       1,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			shards, err := pack(testCase.units, testCase.count, testCase.maxDuration)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError %v", err, want)
+			}
+
+			var got []expectedShard
+			for i, s := range shards {
+				if got, want := s.Index, i+1; got != want {
+					t.Errorf("shards[%d] index = %v, want %v", i, got, want)
+				}
+				if got, want := s.Count, len(shards); got != want {
+					t.Errorf("shards[%d] count = %v, want %v", i, got, want)
+				}
+				got = append(got, expectedShard{
+					Environment: s.Environment,
+					Tests:       testNames(s.Tests),
+					Duration:    s.Duration,
+				})
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanDeterministic(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	testWriteFile(t, filepath.Join(root, "a", "a_test.go"), `package a_test
+
+func TestAccAThing_basic(t *testing.T) {
+	acctest.PreCheck(ctx, t)
+}
+
+func TestAccAThing_serial(t *testing.T) {
+	testAccAThing_locked(t)
+}
+
+func testAccAThing_locked(t *testing.T) {
+	semaphore.GetSemaphore("a_thing")
+}
+`)
+	testWriteFile(t, filepath.Join(root, "b", "b_test.go"), `package b_test
+
+func TestAccBThing_basic(t *testing.T) {
+	semaphore.GetSemaphore("a_thing")
+}
+
+func TestAccBThing_east(t *testing.T) {
+	acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+}
+
+func TestAccBThing_crossAccount(t *testing.T) {
+	acctest.PreCheckAlternateAccount(t)
+	acctest.PreCheckMultipleRegion(t, 2)
+}
+`)
+	packages := []string{"a", "b"}
+
+	expected := testPlan(t, root, packages)
+
+	if got, want := len(expected), 3; got != want {
+		t.Fatalf("number of shards = %v, want %v", got, want)
+	}
+	if got, want := expected[0], `# Code generated by internal/generate/acctestshards/main.go; DO NOT EDIT.
+
+# Shard 1 of 3: partition aws, region us-east-1
+# 1 tests (1 without recorded durations), estimated test time 5m0s, estimated cost $0.00
+
+SHARD_PARTITION = aws
+SHARD_REGION = us-east-1
+SHARD_PACKAGES = ./internal/service/b
+SHARD_TESTS = ^(TestAccBThing_east)$$
+`; got != want {
+		t.Errorf("shards[0] manifest = %v, want %v", got, want)
+	}
+	if got, want := expected[1], `# Code generated by internal/generate/acctestshards/main.go; DO NOT EDIT.
+
+# Shard 2 of 3: partition aws, region us-west-2
+# 2 tests (2 without recorded durations), estimated test time 10m0s, estimated cost $0.00
+
+SHARD_PARTITION = aws
+SHARD_REGION = us-west-2
+SHARD_PACKAGES = ./internal/service/a ./internal/service/b
+SHARD_TESTS = ^(TestAccAThing_serial|TestAccBThing_basic)$$
+`; got != want {
+		t.Errorf("shards[1] manifest = %v, want %v", got, want)
+	}
+
+	for range 10 {
+		if diff := cmp.Diff(expected, testPlan(t, root, packages)); diff != "" {
+			t.Fatalf("unexpected diff between runs (+wanted, -got): %s", diff)
+		}
+	}
+}
+
+// testPlan plans the acceptance tests of service packages under root into 3 shards as the `plan` command does,
+// using the default duration for all tests, and returns the shard manifests.
+func testPlan(t *testing.T, root string, packages []string) []string {
+	t.Helper()
+
+	var (
+		tests        []scheduledTest
+		environments = make(map[string]environment)
+	)
+	for _, p := range packages {
+		acctests, _, err := discoverTests(filepath.Join(root, p), "internal/service/"+p)
+		if err != nil {
+			t.Fatalf("discovering tests in %s: %s", p, err)
+		}
+
+		for _, v := range acctests {
+			tests = append(tests, scheduledTest{
+				acctest:   v,
+				Duration:  5 * time.Minute,
+				Estimated: true,
+			})
+			environments[testKey(v)] = environmentFor(v, "", []string{"us-west-2", "us-east-1"})
+		}
+	}
+
+	us, _ := units(tests, environments)
+	shards, err := pack(us, 3, 0)
+	if err != nil {
+		t.Fatalf("packing shards: %s", err)
+	}
+
+	var manifests []string
+	for _, s := range shards {
+		manifests = append(manifests, s.manifest())
+	}
+
+	return manifests
+}
+
+func testScheduledTest(name string, duration time.Duration, semaphores ...string) scheduledTest {
+	return scheduledTest{
+		acctest: acctest{
+			Package:    "internal/service/a",
+			Name:       name,
+			Semaphores: semaphores,
+		},
+		Duration: duration,
+	}
+}
+
+func testUnit(e environment, name string, duration time.Duration) *unit {
+	return &unit{
+		Environment: e,
+		Tests:       []scheduledTest{testScheduledTest(name, duration)},
+		Duration:    duration,
+	}
+}
+
+func testNames(tests []scheduledTest) []string {
+	var names []string
+	for _, t := range tests {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+func testWriteFile(t *testing.T, filename, contents string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"cmp"
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+var (
+	servicePackageRoot = flag.String("ServicePackageRoot", "internal/service", "path to service package root directory")
+)
+
+// The identity report lists, per service package, the resources that do not yet implement Resource Identity
+// and the identity-bearing resources that have no generated identity acceptance tests.
+// Run from the repository root, e.g. `make identity-report`.
+func main() {
+	flag.Parse()
+	args := flag.Args()
+
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	var (
+		packages []string
+		errs     []error
+	)
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		p := l.ProviderPackage()
+		if p == "" || slices.Contains(packages, p) {
+			continue
+		}
+
+		if _, err := os.Stat(path.Join(*servicePackageRoot, p)); err != nil {
+			continue
+		}
+
+		packages = append(packages, p)
+	}
+	slices.Sort(packages)
+
+	td := TemplateData{}
+
+	for _, p := range packages {
+		v := &visitor{
+			g:           g,
+			packageName: p,
+		}
+
+		v.processDir(path.Join(*servicePackageRoot, p))
+
+		errs = append(errs, v.errs...)
+
+		slices.SortFunc(v.resources, func(a, b resourceDatum) int {
+			return cmp.Compare(a.TypeName, b.TypeName)
+		})
+
+		s := serviceDatum{
+			ProviderPackage: p,
+		}
+
+		for _, r := range v.resources {
+			td.Total++
+
+			switch {
+			case !r.HasIdentity():
+				s.WithoutIdentity = append(s.WithoutIdentity, r)
+			case !r.HasGeneratedTest:
+				td.WithIdentity++
+				s.WithoutTests = append(s.WithoutTests, r)
+			default:
+				td.WithIdentity++
+				td.WithTests++
+			}
+
+			switch {
+			case r.ARNIdentity:
+				td.ARNIdentity++
+			case r.SingletonIdentity:
+				td.SingletonIdentity++
+			case r.ParameterizedIdentity:
+				td.ParameterizedIdentity++
+			}
+		}
+
+		if len(s.WithoutIdentity) > 0 || len(s.WithoutTests) > 0 {
+			td.Services = append(td.Services, s)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	tmpl, err := template.New("report").Parse(reportTmpl)
+	if err != nil {
+		g.Fatalf("parsing report template: %s", err)
+	}
+
+	if len(args) == 0 {
+		if err := tmpl.Execute(os.Stdout, td); err != nil {
+			g.Fatalf("generating identity report: %s", err)
+		}
+		return
+	}
+
+	filename := args[0]
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferTemplateSet(tmpl, td); err != nil {
+		g.Fatalf("generating identity report: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type TemplateData struct {
+	Total                 int
+	WithIdentity          int
+	WithTests             int
+	ARNIdentity           int
+	ParameterizedIdentity int
+	SingletonIdentity     int
+	Services              []serviceDatum
+}
+
+func (td TemplateData) WithoutIdentity() int {
+	return td.Total - td.WithIdentity
+}
+
+func (td TemplateData) Coverage() string {
+	if td.Total == 0 {
+		return "0.0"
+	}
+	return fmt.Sprintf("%.1f", float64(td.WithIdentity)*100/float64(td.Total))
+}
+
+type serviceDatum struct {
+	ProviderPackage string
+	WithoutIdentity []resourceDatum
+	WithoutTests    []resourceDatum
+}
+
+type resourceDatum struct {
+	TypeName              string
+	Implementation        string
+	FileName              string
+	ARNIdentity           bool
+	ParameterizedIdentity bool
+	SingletonIdentity     bool
+	HasGeneratedTest      bool
+	Reason                string
+}
+
+func (r resourceDatum) HasIdentity() bool {
+	return r.ARNIdentity || r.ParameterizedIdentity || r.SingletonIdentity
+}
+
+//go:embed report.gtpl
+var reportTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	dirName      string
+	fileName     string
+	functionName string
+	packageName  string
+
+	resources []resourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	v.dirName = path
+
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for _, pkg := range packageMap {
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			ast.Walk(v, file)
+
+			v.fileName = ""
+		}
+	}
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := resourceDatum{
+		FileName: filepath.Base(v.fileName),
+	}
+	skip := false
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName := m[1]; annotationName {
+			case "FrameworkResource", "SDKResource":
+				args := common.ParseArgs(m[3])
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				d.TypeName = args.Positional[0]
+				if annotationName == "FrameworkResource" {
+					d.Implementation = "Framework"
+				} else {
+					d.Implementation = "SDK"
+				}
+
+			case "ArnIdentity":
+				d.ARNIdentity = true
+
+			case "IdentityAttribute":
+				d.ParameterizedIdentity = true
+
+			case "SingletonIdentity":
+				d.SingletonIdentity = true
+
+			case "Testing":
+				args := common.ParseArgs(m[3])
+				if attr, ok := args.Keyword["identityTest"]; ok && attr == "false" {
+					skip = true
+				}
+			}
+		}
+	}
+
+	if d.TypeName != "" {
+		if d.HasIdentity() {
+			// See internal/generate/identitytests/main.go.
+			sourceName := strings.TrimSuffix(strings.TrimSuffix(d.FileName, filepath.Ext(d.FileName)), "_")
+			testFile := path.Join(v.dirName, fmt.Sprintf("%s_identity_gen_test.go", sourceName))
+
+			if _, err := os.Stat(testFile); err == nil {
+				d.HasGeneratedTest = true
+			} else if !errors.Is(err, os.ErrNotExist) {
+				v.errs = append(v.errs, fmt.Errorf("accessing %q: %w", testFile, err))
+			} else if skip {
+				d.Reason = "`@Testing(identityTest=false)`"
+			} else {
+				d.Reason = "no config template"
+			}
+		}
+
+		v.resources = append(v.resources, d)
+	}
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
@@ -116,6 +116,34 @@ func main() {
 			}
 		}
 
+		basicConfigTmplFile := fmt.Sprintf("%s_basic.gtpl", sourceName)
+		basicConfigTmplPath := path.Join("testdata", "tmpl", basicConfigTmplFile)
+		var configTmplFile string
+		var configTmplPath string
+		if _, err := os.Stat(basicConfigTmplPath); err == nil {
+			configTmplFile = basicConfigTmplFile
+			configTmplPath = basicConfigTmplPath
+		} else if !errors.Is(err, os.ErrNotExist) {
+			g.Fatalf("accessing config template %q: %w", basicConfigTmplPath, err)
+		}
+
+		tagsConfigTmplFile := fmt.Sprintf("%s_tags.gtpl", sourceName)
+		tagsConfigTmplPath := path.Join("testdata", "tmpl", tagsConfigTmplFile)
+		if configTmplPath == "" {
+			if _, err := os.Stat(tagsConfigTmplPath); err == nil {
+				configTmplFile = tagsConfigTmplFile
+				configTmplPath = tagsConfigTmplPath
+			} else if !errors.Is(err, os.ErrNotExist) {
+				g.Fatalf("accessing config template %q: %w", tagsConfigTmplPath, err)
+			}
+		}
+
+		// Without a config template no test configurations can be generated, so skip the Go test as well.
+		if configTmplPath == "" {
+			g.Errorf("no config template found for %q at %q or %q", sourceName, basicConfigTmplPath, tagsConfigTmplPath)
+			continue
+		}
+
 		filename := fmt.Sprintf("%s_identity_gen_test.go", sourceName)
 
 		d := g.NewGoFileDestination(filename)
@@ -146,33 +174,6 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
-		basicConfigTmplFile := fmt.Sprintf("%s_basic.gtpl", sourceName)
-		basicConfigTmplPath := path.Join("testdata", "tmpl", basicConfigTmplFile)
-		var configTmplFile string
-		var configTmplPath string
-		if _, err := os.Stat(basicConfigTmplPath); err == nil {
-			configTmplFile = basicConfigTmplFile
-			configTmplPath = basicConfigTmplPath
-		} else if !errors.Is(err, os.ErrNotExist) {
-			g.Fatalf("accessing config template %q: %w", basicConfigTmplPath, err)
-		}
-
-		tagsConfigTmplFile := fmt.Sprintf("%s_tags.gtpl", sourceName)
-		tagsConfigTmplPath := path.Join("testdata", "tmpl", tagsConfigTmplFile)
-		if configTmplPath == "" {
-			if _, err := os.Stat(tagsConfigTmplPath); err == nil {
-				configTmplFile = tagsConfigTmplFile
-				configTmplPath = tagsConfigTmplPath
-			} else if !errors.Is(err, os.ErrNotExist) {
-				g.Fatalf("accessing config template %q: %w", tagsConfigTmplPath, err)
-			}
-		}
-
-		if configTmplPath == "" {
-			g.Errorf("no config template found for %q at %q or %q", sourceName, basicConfigTmplPath, tagsConfigTmplPath)
-			continue
-		}
-
 		b, err := os.ReadFile(configTmplPath)
 		if err != nil {
 			g.Fatalf("reading config template %q: %w", configTmplPath, err)
@@ -451,6 +452,12 @@ func (r ResourceDatum) IsARNFormatGlobal() bool {
 	return r.isARNFormatGlobal == triBooleanTrue
 }
 
+// GenerateIdentityImportCheck returns whether the identity and attribute values after import by identity are compared with the values after create.
+// Resources which are expected to plan changes after import are excluded.
+func (r ResourceDatum) GenerateIdentityImportCheck() bool {
+	return !r.NoImport && r.PlannableResourceAction() == "NoOp"
+}
+
 func (r ResourceDatum) IdentityAttributes() []identityAttribute {
 	return r.identityAttributes
 }
@@ -595,6 +602,8 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				if attr, ok := args.Keyword["name"]; ok {
 					attr = strings.ReplaceAll(attr, " ", "")
 					d.Name = strings.ReplaceAll(attr, "-", "")
+				} else if m := sdkNameRegexp.FindStringSubmatch(v.functionName); m != nil {
+					d.Name = m[1]
 				}
 
 			case "ArnIdentity":
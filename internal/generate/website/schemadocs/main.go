@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+var (
+	check               = flag.Bool("check", false, "report mismatches without modifying documentation and exit with a non-zero status if any are found")
+	regen               = flag.Bool("regenerate", false, "additionally rewrite the Argument Reference and Attribute Reference bullets in place from the schema")
+	servicePackages     = flag.String("service-packages", "", "comma-separated list of service packages, e.g. logs,s3, whose resources' and data sources' documentation is also processed")
+	servicePackagesRoot = flag.String("ServicePackagesRoot", "internal/service", "path to service packages root directory")
+	websiteRoot         = flag.String("WebsiteRoot", "website/docs", "path to website documentation root directory")
+)
+
+// The schema documentation checker compares the Argument Reference, Attribute Reference and Identity Schema
+// sections of each resource's and data source's documentation with the schema registered in the provider.
+// Mismatches that can be corrected in place (argument flags, defaults and undocumented attributes with schema descriptions)
+// are fixed unless -check is specified; everything else is reported.
+// With -regenerate, the top-level bullets of the Argument Reference and Attribute Reference sections are also rewritten
+// from the schema: they are sorted, bullets for attributes not in the schema are removed and undocumented attributes are added.
+// Documentation file paths may be passed as arguments to restrict the run to those files,
+// and -service-packages adds the documentation of every resource and data source registered in those service packages.
+// Run from the repository root, e.g. `make website-schema-docs`.
+//
+// Unlike the other generators this is not built with the `generate` tag, which excludes the generated tagging
+// functions some service packages require.
+func main() {
+	flag.Parse()
+	args := flag.Args()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	if *check && *regen {
+		g.Fatalf("-check and -regenerate are mutually exclusive")
+	}
+
+	docs, err := loadSchemas(ctx)
+
+	if err != nil {
+		g.Fatalf("error loading provider schemas: %s", err)
+	}
+
+	for i, arg := range args {
+		args[i] = filepath.Clean(arg)
+	}
+
+	restricted := len(args) > 0
+
+	for servicePackage := range strings.SplitSeq(*servicePackages, ",") {
+		servicePackage = strings.TrimSpace(servicePackage)
+		if servicePackage == "" {
+			continue
+		}
+		restricted = true
+
+		filename := servicePackageGenFilename(servicePackage)
+		filenames, err := servicePackageDocFilenames(filename, nil)
+
+		// Directories without a generated service package file, e.g. a package removed by the change, have no documentation to check.
+		if errors.Is(err, os.ErrNotExist) {
+			g.Infof("Skipping %s: %s not found", servicePackage, filename)
+			continue
+		}
+
+		if err != nil {
+			g.Fatalf("error reading service package %s: %s", servicePackage, err)
+		}
+
+		args = append(args, filenames...)
+	}
+
+	var failed bool
+
+	for _, doc := range docs {
+		doc.normalize()
+
+		filename := docFilename(doc)
+
+		if restricted && !slices.Contains(args, filename) {
+			continue
+		}
+
+		b, err := os.ReadFile(filename)
+
+		if errors.Is(err, os.ErrNotExist) {
+			g.Errorf("%s: documentation file %s not found", doc.TypeName, filename)
+			failed = true
+			continue
+		}
+
+		if err != nil {
+			g.Fatalf("error reading %s: %s", filename, err)
+		}
+
+		content, findings := reconcile(string(b), doc, !*check)
+
+		if *regen {
+			content = regenerate(content, doc)
+		}
+
+		if !*check && content != string(b) {
+			g.Infof("Updating %s", filename)
+
+			if err := os.WriteFile(filename, []byte(content), 0644); err != nil { //nolint:mnd // good protection for new files
+				g.Fatalf("error writing %s: %s", filename, err)
+			}
+		}
+
+		for _, finding := range findings {
+			if *check {
+				g.Errorf("%s: %s", filename, finding)
+			} else {
+				g.Warnf("%s: %s", filename, finding)
+			}
+		}
+
+		if len(findings) > 0 {
+			failed = true
+		}
+	}
+
+	if failed && *check {
+		os.Exit(1)
+	}
+}
+
+// docFilename returns the path of the documentation file for a resource or data source,
+// e.g. website/docs/r/s3_bucket.html.markdown for aws_s3_bucket.
+func docFilename(doc schemaDoc) string {
+	dir := "r"
+	if doc.DataSource {
+		dir = "d"
+	}
+
+	return filepath.Join(*websiteRoot, dir, strings.TrimPrefix(doc.TypeName, "aws_")+".html.markdown")
+}
@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	bulletRegexp  = regexp.MustCompile("^([*-]) `([0-9a-z_]+)`( -)? (.*)$") // nosemgrep:ci.calling-regexp.MustCompile-directly
+	flagRegexp    = regexp.MustCompile(`^\((Required|Optional)\b([^)]*)\)`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+	defaultRegexp = regexp.MustCompile("[Dd]efaults to `([^`]*)`")          // nosemgrep:ci.calling-regexp.MustCompile-directly
+	// Matches paragraphs introducing a nested block's bullets, e.g. "The `metric_transformation` block supports the following arguments:".
+	nestedRegexp = regexp.MustCompile("^(The )?`[0-9a-z_]+`.*:$") // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+var (
+	argumentSectionTitles  = []string{"Argument Reference"}
+	attributeSectionTitles = []string{"Attribute Reference", "Attributes Reference"}
+)
+
+const identitySectionTitle = "Identity Schema"
+
+// reconcile compares a resource's or data source's documentation with its schema.
+// Mismatches are returned as findings and, if fix is true, those that can be corrected in place are corrected in the returned content.
+func reconcile(content string, doc schemaDoc, fix bool) (string, []string) {
+	r := reconciler{
+		doc:   doc,
+		fix:   fix,
+		lines: strings.Split(content, "\n"),
+	}
+
+	r.reconcileArguments()
+	r.reconcileAttributes()
+	r.reconcileIdentity()
+
+	return strings.Join(r.lines, "\n"), r.findings
+}
+
+// regenerate rewrites the top-level bullets of the Argument Reference and Attribute Reference sections of
+// reconciled documentation in place from the schema.
+// Bullets are ordered by name, those for attributes not in the schema are removed and undocumented attributes
+// with schema descriptions are added. The text of existing bullets, and any lines following them, is kept.
+func regenerate(content string, doc schemaDoc) string {
+	r := reconciler{
+		doc:   doc,
+		fix:   true,
+		lines: strings.Split(content, "\n"),
+	}
+
+	// Optional and Computed attributes such as `tags_all` may be documented in the Attribute Reference.
+	var inAttributes []string
+	for _, b := range r.topLevelBullets(r.section(attributeSectionTitles...)) {
+		inAttributes = append(inAttributes, b.name)
+	}
+
+	r.regenerateSection(argumentSectionTitles, true, func(a attributeDoc) bool {
+		return a.IsArgument() && !(a.Computed && slices.Contains(inAttributes, a.Name))
+	})
+	r.regenerateSection(attributeSectionTitles, false, func(a attributeDoc) bool {
+		return !a.IsArgument() || slices.Contains(inAttributes, a.Name)
+	})
+
+	return strings.Join(r.lines, "\n")
+}
+
+type reconciler struct {
+	doc      schemaDoc
+	fix      bool
+	lines    []string
+	findings []string
+}
+
+type bullet struct {
+	index     int
+	marker    string
+	name      string
+	separated bool // The name is followed by " - ".
+	text      string
+}
+
+func (r *reconciler) findingf(format string, a ...any) {
+	r.findings = append(r.findings, fmt.Sprintf(format, a...))
+}
+
+func (r *reconciler) reconcileArguments() {
+	start, end, ok := r.section(argumentSectionTitles...)
+	if !ok {
+		if slices.ContainsFunc(r.doc.Attributes, attributeDoc.IsArgument) {
+			r.findingf("missing %q section", argumentSectionTitles[0])
+		}
+		return
+	}
+
+	bullets := r.topLevelBullets(start, end, ok)
+
+	for _, b := range bullets {
+		a, ok := r.doc.attribute(b.name)
+
+		switch {
+		case !ok:
+			if b.name != "id" {
+				r.findingf("argument `%s` is documented but is not in the schema", b.name)
+			}
+			continue
+		case !a.IsArgument():
+			r.findingf("argument `%s` is documented but is computed-only", b.name)
+			continue
+		}
+
+		if !b.separated {
+			r.findingf("argument `%s` is missing the ` - ` separator", b.name)
+		}
+
+		text := b.text
+
+		want := "Optional"
+		if a.Required {
+			want = "Required"
+		}
+
+		switch m := flagRegexp.FindStringSubmatch(text); {
+		case m == nil:
+			r.findingf("argument `%s` is not marked (%s)", b.name, want)
+			text = fmt.Sprintf("(%s) %s", want, text)
+		case m[1] != want:
+			r.findingf("argument `%s` is documented as %s, but is %s", b.name, m[1], want)
+			text = "(" + want + strings.TrimPrefix(text, "("+m[1])
+		}
+
+		if a.WriteOnly {
+			if m := flagRegexp.FindStringSubmatch(text); !strings.Contains(m[2], "Write-Only") {
+				r.findingf("argument `%s` is not marked Write-Only", b.name)
+				text = "(" + want + ", Write-Only" + strings.TrimPrefix(text, "("+want)
+			}
+		}
+
+		if a.Default != "" {
+			if m := defaultRegexp.FindStringSubmatch(text); m != nil && m[1] != a.Default {
+				r.findingf("argument `%s` is documented as defaulting to `%s`, but defaults to `%s`", b.name, m[1], a.Default)
+				text = strings.Replace(text, m[0], strings.Replace(m[0], "`"+m[1]+"`", "`"+a.Default+"`", 1), 1)
+			}
+		}
+
+		if r.fix && (text != b.text || !b.separated) {
+			r.lines[b.index] = fmt.Sprintf("%s `%s` - %s", b.marker, b.name, text)
+		}
+	}
+
+	// Optional and Computed attributes such as `tags_all` may instead be documented in the Attribute Reference.
+	attributes := r.topLevelBullets(r.section(attributeSectionTitles...))
+
+	for _, a := range r.doc.Attributes {
+		if !a.IsArgument() || slices.ContainsFunc(bullets, func(b bullet) bool { return b.name == a.Name }) {
+			continue
+		}
+		if a.Computed && slices.ContainsFunc(attributes, func(b bullet) bool { return b.name == a.Name }) {
+			continue
+		}
+
+		r.findingf("argument `%s` is not documented", a.Name)
+		r.insertBullet(bullets, a, true)
+		bullets = r.topLevelBullets(r.section(argumentSectionTitles...))
+	}
+}
+
+func (r *reconciler) reconcileAttributes() {
+	var documented []string
+	for _, b := range r.topLevelBullets(r.section(argumentSectionTitles...)) {
+		documented = append(documented, b.name)
+	}
+
+	start, end, ok := r.section(attributeSectionTitles...)
+	if !ok {
+		if slices.ContainsFunc(r.doc.Attributes, func(a attributeDoc) bool { return !a.IsArgument() }) {
+			r.findingf("missing %q section", attributeSectionTitles[0])
+		}
+		return
+	}
+
+	bullets := r.topLevelBullets(start, end, ok)
+
+	for _, b := range bullets {
+		documented = append(documented, b.name)
+
+		if !b.separated {
+			r.findingf("attribute `%s` is missing the ` - ` separator", b.name)
+			if r.fix {
+				r.lines[b.index] = fmt.Sprintf("%s `%s` - %s", b.marker, b.name, b.text)
+			}
+		}
+
+		if _, ok := r.doc.attribute(b.name); !ok && b.name != "id" {
+			r.findingf("attribute `%s` is documented but is not in the schema", b.name)
+		}
+	}
+
+	for _, a := range r.doc.Attributes {
+		if a.IsArgument() || slices.Contains(documented, a.Name) {
+			continue
+		}
+
+		r.findingf("attribute `%s` is not documented", a.Name)
+		r.insertBullet(bullets, a, false)
+		bullets = r.topLevelBullets(r.section(attributeSectionTitles...))
+	}
+}
+
+// reconcileIdentity checks the "Identity Schema" subsection of the Import section, which lists
+// identity attributes under "Required" and "Optional" headings.
+func (r *reconciler) reconcileIdentity() {
+	if len(r.doc.Identity) == 0 {
+		return
+	}
+
+	start := slices.IndexFunc(r.lines, func(line string) bool {
+		return strings.TrimSpace(strings.TrimPrefix(line, "###")) == identitySectionTitle && strings.HasPrefix(line, "### ")
+	})
+	if start == -1 {
+		r.findingf("identity attributes are not documented in an %q section", identitySectionTitle)
+		return
+	}
+
+	documented := make(map[string]bool) // Name -> Required.
+	required := false
+	for _, line := range r.lines[start+1:] {
+		if strings.HasPrefix(line, "## ") || strings.HasPrefix(line, "### ") {
+			break
+		}
+		if strings.HasPrefix(line, "#### ") {
+			required = strings.TrimSpace(strings.TrimPrefix(line, "####")) == "Required"
+			continue
+		}
+		if m := bulletRegexp.FindStringSubmatch(line); m != nil {
+			documented[m[2]] = required
+		}
+	}
+
+	for _, a := range r.doc.Identity {
+		switch required, ok := documented[a.Name]; {
+		case !ok:
+			r.findingf("identity attribute `%s` is not documented", a.Name)
+		case required != a.Required:
+			r.findingf("identity attribute `%s` is documented as %s, but is %s", a.Name, requiredString(required), requiredString(a.Required))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(documented)) {
+		if !slices.ContainsFunc(r.doc.Identity, func(a identityAttributeDoc) bool { return a.Name == name }) {
+			r.findingf("identity attribute `%s` is documented but is not in the identity schema", name)
+		}
+	}
+}
+
+// insertBullet inserts a generated bullet for an undocumented attribute, keeping the bullets in alphabetical order.
+// Attributes without any descriptive text are only reported.
+func (r *reconciler) insertBullet(bullets []bullet, a attributeDoc, argument bool) {
+	if !r.fix || len(bullets) == 0 {
+		return
+	}
+
+	text := bulletText(a, argument)
+	if text == "" {
+		return
+	}
+
+	index := bullets[len(bullets)-1].index + 1
+	if i := slices.IndexFunc(bullets, func(b bullet) bool { return b.name > a.Name }); i != -1 {
+		index = bullets[i].index
+	}
+
+	line := fmt.Sprintf("%s `%s` - %s", bullets[0].marker, a.Name, text)
+	r.lines = slices.Insert(r.lines, index, line)
+}
+
+// regenerateSection rewrites the top-level bullets of the section with one of the specified titles
+// for the schema attributes that belong in it.
+func (r *reconciler) regenerateSection(titles []string, argument bool, belongs func(attributeDoc) bool) {
+	start, end, ok := r.section(titles...)
+	bullets := r.topLevelBullets(start, end, ok)
+	if len(bullets) == 0 {
+		return
+	}
+
+	// Each bullet is kept together with any lines following it up to the next bullet.
+	// Only indented lines following the last bullet are kept with it, so any trailing paragraphs stay in place.
+	items := make(map[string][]string)
+	last := bullets[len(bullets)-1].index + 1
+	for last < end && strings.HasPrefix(r.lines[last], " ") {
+		last++
+	}
+	for i, b := range bullets {
+		next := last
+		if i+1 < len(bullets) {
+			next = bullets[i+1].index
+		}
+		items[b.name] = trimTrailingBlankLines(r.lines[b.index:next])
+	}
+
+	var names []string
+	for _, a := range r.doc.Attributes {
+		if belongs(a) {
+			names = append(names, a.Name)
+		}
+	}
+	if _, ok := items["id"]; ok && !argument {
+		names = append(names, "id")
+	}
+	slices.Sort(names)
+
+	var (
+		lines    []string
+		separate bool // The previous item ends with paragraphs.
+	)
+	for _, name := range names {
+		item, ok := items[name]
+		if !ok {
+			a, _ := r.doc.attribute(name)
+			text := bulletText(a, argument)
+			if text == "" {
+				continue
+			}
+			item = []string{fmt.Sprintf("%s `%s` - %s", bullets[0].marker, name, text)}
+		}
+
+		// Items ending with paragraphs, e.g. notes, are separated from the following item by a blank line.
+		if separate {
+			lines = append(lines, "")
+		}
+		lines = append(lines, item...)
+		separate = slices.ContainsFunc(item[1:], func(line string) bool {
+			return line != "" && !strings.HasPrefix(line, " ")
+		})
+	}
+
+	r.lines = slices.Replace(r.lines, bullets[0].index, last, lines...)
+}
+
+func trimTrailingBlankLines(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// section returns the line range of the level 2 section with one of the specified titles.
+func (r *reconciler) section(titles ...string) (int, int, bool) {
+	start, end := -1, len(r.lines)
+	inCode := false
+
+	for i, line := range r.lines {
+		if strings.HasPrefix(line, "```") {
+			inCode = !inCode
+		}
+		if inCode || !strings.HasPrefix(line, "## ") {
+			continue
+		}
+
+		if start != -1 {
+			end = i
+			break
+		}
+		if slices.Contains(titles, strings.TrimSpace(strings.TrimPrefix(line, "##"))) {
+			start = i
+		}
+	}
+
+	return start, end, start != -1
+}
+
+// topLevelBullets returns the attribute bullets of a section that precede any subsection or paragraph introducing
+// a nested block's bullets, i.e. those for top-level attributes.
+func (r *reconciler) topLevelBullets(start, end int, ok bool) []bullet {
+	if !ok {
+		return nil
+	}
+
+	var bullets []bullet
+	for i := start + 1; i < end; i++ {
+		line := r.lines[i]
+		if strings.HasPrefix(line, "### ") || nestedRegexp.MatchString(strings.TrimSpace(line)) {
+			break
+		}
+		if m := bulletRegexp.FindStringSubmatch(line); m != nil {
+			bullets = append(bullets, bullet{
+				index:     i,
+				marker:    m[1],
+				name:      m[2],
+				separated: m[3] != "",
+				text:      m[4],
+			})
+		}
+	}
+
+	return bullets
+}
+
+// bulletText returns the documentation text for an attribute from its schema description, validators and default.
+func bulletText(a attributeDoc, argument bool) string {
+	var sentences []string
+	for _, s := range append([]string{a.Description}, a.Validators...) {
+		if s = strings.TrimSpace(s); s != "" {
+			sentences = append(sentences, sentence(s))
+		}
+	}
+	if a.Default != "" {
+		sentences = append(sentences, fmt.Sprintf("Defaults to `%s`.", a.Default))
+	}
+	if len(sentences) == 0 {
+		return ""
+	}
+
+	text := strings.Join(sentences, " ")
+	if !argument {
+		return text
+	}
+
+	flags := []string{"Optional"}
+	if a.Required {
+		flags[0] = "Required"
+	}
+	if a.WriteOnly {
+		flags = append(flags, "Write-Only")
+	}
+	if a.Deprecated {
+		flags = append(flags, "**Deprecated**")
+	}
+
+	return fmt.Sprintf("(%s) %s", strings.Join(flags, ", "), text)
+}
+
+// sentence capitalizes the first letter of s and ensures that it ends with a period.
+func sentence(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	s = string(unicode.ToUpper(r)) + s[size:]
+	if !strings.HasSuffix(s, ".") {
+		s += "."
+	}
+	return s
+}
+
+func requiredString(required bool) string {
+	if required {
+		return "Required"
+	}
+	return "Optional"
+}
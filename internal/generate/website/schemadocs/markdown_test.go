@@ -0,0 +1,472 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTopLevelBullets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content  string
+		titles   []string
+		expected []bullet
+	}{
+		"no section": {
+			content: `## Example Usage
+
+* ` + "`name`" + ` - (Required) Name.
+`,
+			titles: argumentSectionTitles,
+		},
+		"bullets": {
+			content: `## Argument Reference
+
+This resource supports the following arguments:
+
+* ` + "`name`" + ` - (Required) Name.
+- ` + "`tags`" + ` (Optional) Tags.
+
+## Attribute Reference
+`,
+			titles: argumentSectionTitles,
+			expected: []bullet{
+				{index: 4, marker: "*", name: "name", separated: true, text: "(Required) Name."},
+				{index: 5, marker: "-", name: "tags", text: "(Optional) Tags."},
+			},
+		},
+		"subsections and code blocks": {
+			content: `## Attributes Reference
+
+` + "```" + `
+## Not a heading
+` + "```" + `
+
+* ` + "`arn`" + ` - ARN.
+
+### nested
+
+* ` + "`id`" + ` - Nested ID.
+`,
+			titles: attributeSectionTitles,
+			expected: []bullet{
+				{index: 6, marker: "*", name: "arn", separated: true, text: "ARN."},
+			},
+		},
+		"nested block paragraph": {
+			content: `## Argument Reference
+
+The following arguments are required:
+
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`transformation`" + ` - (Required) Transformation. See below.
+
+The following arguments are optional:
+
+* ` + "`tags`" + ` - (Optional) Tags.
+
+The ` + "`transformation`" + ` block supports the following arguments:
+
+* ` + "`value`" + ` - (Required) Value.
+`,
+			titles: argumentSectionTitles,
+			expected: []bullet{
+				{index: 4, marker: "*", name: "name", separated: true, text: "(Required) Name."},
+				{index: 5, marker: "*", name: "transformation", separated: true, text: "(Required) Transformation. See below."},
+				{index: 9, marker: "*", name: "tags", separated: true, text: "(Optional) Tags."},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := reconciler{
+				lines: strings.Split(testCase.content, "\n"),
+			}
+
+			got := r.topLevelBullets(r.section(testCase.titles...))
+
+			if diff := cmp.Diff(testCase.expected, got, cmp.AllowUnexported(bullet{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	doc := schemaDoc{
+		TypeName: "aws_example",
+		Attributes: []attributeDoc{
+			{Name: "arn", Computed: true, Description: "ARN of the example"},
+			{Name: "config", Optional: true, Block: true},
+			{Name: "name", Required: true},
+			{Name: "password", Optional: true, WriteOnly: true},
+			{Name: "port", Optional: true, Default: "443"},
+			{Name: "rule", Required: true, Block: true},
+			{Name: "tags", Optional: true},
+			{Name: "tags_all", Optional: true, Computed: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		content          string
+		fix              bool
+		expectedContent  string
+		expectedFindings []string
+	}{
+		"matches": {
+			content: `## Argument Reference
+
+* ` + "`config`" + ` - (Optional) Configuration block.
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`password`" + ` - (Optional, Write-Only) Password.
+* ` + "`port`" + ` - (Optional) Port. Defaults to ` + "`443`" + `.
+* ` + "`rule`" + ` - (Required) Rule block.
+* ` + "`tags`" + ` - (Optional) Tags.
+
+## Attribute Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`id`" + ` - ID.
+* ` + "`tags_all`" + ` - Tags.
+`,
+		},
+		"mismatches reported": {
+			content: `## Argument Reference
+
+* ` + "`config`" + ` - (Required) Configuration block.
+* ` + "`name`" + ` (Optional) Name.
+* ` + "`password`" + ` - (Optional) Password.
+* ` + "`port`" + ` - Port. Defaults to ` + "`80`" + `.
+* ` + "`rule`" + ` - (Optional) Rule block.
+* ` + "`removed`" + ` - (Optional) Removed.
+* ` + "`tags`" + ` - (Optional) Tags.
+
+## Attribute Reference
+
+* ` + "`id`" + ` - ID.
+* ` + "`tags_all`" + ` - Tags.
+`,
+			expectedFindings: []string{
+				"argument `config` is documented as Required, but is Optional",
+				"argument `name` is missing the ` - ` separator",
+				"argument `name` is documented as Optional, but is Required",
+				"argument `password` is not marked Write-Only",
+				"argument `port` is not marked (Optional)",
+				"argument `port` is documented as defaulting to `80`, but defaults to `443`",
+				"argument `rule` is documented as Optional, but is Required",
+				"argument `removed` is documented but is not in the schema",
+				"attribute `arn` is not documented",
+			},
+		},
+		"mismatches fixed": {
+			content: `## Argument Reference
+
+* ` + "`config`" + ` - (Required) Configuration block.
+* ` + "`name`" + ` (Optional) Name.
+* ` + "`password`" + ` - (Optional) Password.
+* ` + "`port`" + ` - Port. Defaults to ` + "`80`" + `.
+* ` + "`rule`" + ` - (Optional) Rule block.
+* ` + "`tags`" + ` - (Optional) Tags.
+
+## Attribute Reference
+
+* ` + "`id`" + ` - ID.
+* ` + "`tags_all`" + ` - Tags.
+`,
+			fix: true,
+			expectedContent: `## Argument Reference
+
+* ` + "`config`" + ` - (Optional) Configuration block.
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`password`" + ` - (Optional, Write-Only) Password.
+* ` + "`port`" + ` - (Optional) Port. Defaults to ` + "`443`" + `.
+* ` + "`rule`" + ` - (Required) Rule block.
+* ` + "`tags`" + ` - (Optional) Tags.
+
+## Attribute Reference
+
+* ` + "`arn`" + ` - ARN of the example.
+* ` + "`id`" + ` - ID.
+* ` + "`tags_all`" + ` - Tags.
+`,
+			expectedFindings: []string{
+				"argument `config` is documented as Required, but is Optional",
+				"argument `name` is missing the ` - ` separator",
+				"argument `name` is documented as Optional, but is Required",
+				"argument `password` is not marked Write-Only",
+				"argument `port` is not marked (Optional)",
+				"argument `port` is documented as defaulting to `80`, but defaults to `443`",
+				"argument `rule` is documented as Optional, but is Required",
+				"attribute `arn` is not documented",
+			},
+		},
+		"missing sections": {
+			content: `## Example Usage
+`,
+			expectedFindings: []string{
+				`missing "Argument Reference" section`,
+				`missing "Attribute Reference" section`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expectedContent := testCase.expectedContent
+			if expectedContent == "" {
+				expectedContent = testCase.content
+			}
+
+			content, findings := reconcile(testCase.content, doc, testCase.fix)
+
+			if diff := cmp.Diff(expectedContent, content); diff != "" {
+				t.Errorf("unexpected content diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(testCase.expectedFindings, findings); diff != "" {
+				t.Errorf("unexpected findings diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileIdentity(t *testing.T) {
+	t.Parallel()
+
+	doc := schemaDoc{
+		TypeName: "aws_example",
+		Identity: []identityAttributeDoc{
+			{Name: "name", Required: true},
+			{Name: "region"},
+		},
+	}
+
+	testCases := map[string]struct {
+		content          string
+		expectedFindings []string
+	}{
+		"matches": {
+			content: `## Import
+
+### Identity Schema
+
+#### Required
+
+* ` + "`name`" + ` - Name.
+
+#### Optional
+
+* ` + "`region`" + ` - Region.
+`,
+		},
+		"mismatches": {
+			content: `## Import
+
+### Identity Schema
+
+#### Required
+
+* ` + "`region`" + ` - Region.
+* ` + "`account_id`" + ` - Account ID.
+`,
+			expectedFindings: []string{
+				"identity attribute `name` is not documented",
+				"identity attribute `region` is documented as Required, but is Optional",
+				"identity attribute `account_id` is documented but is not in the identity schema",
+			},
+		},
+		"missing section": {
+			content: `## Import
+`,
+			expectedFindings: []string{
+				`identity attributes are not documented in an "Identity Schema" section`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := reconciler{
+				doc:   doc,
+				lines: strings.Split(testCase.content, "\n"),
+			}
+
+			r.reconcileIdentity()
+
+			if diff := cmp.Diff(testCase.expectedFindings, r.findings); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRegenerate(t *testing.T) {
+	t.Parallel()
+
+	doc := schemaDoc{
+		TypeName: "aws_example",
+		Attributes: []attributeDoc{
+			{Name: "arn", Computed: true, Description: "ARN of the example"},
+			{Name: "created_at", Computed: true},
+			{Name: "description", Optional: true, Description: "Description of the example"},
+			{Name: "name", Required: true},
+			{Name: "tags", Optional: true},
+			{Name: "tags_all", Optional: true, Computed: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		content  string
+		expected string
+	}{
+		"sorted": {
+			content: `## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`tags`" + ` - (Optional) Tags.
+* ` + "`name`" + ` - (Required) Name.
+  Must be unique.
+
+## Attribute Reference
+
+* ` + "`tags_all`" + ` - Tags.
+* ` + "`arn`" + ` - ARN.
+* ` + "`id`" + ` - ID.
+`,
+			expected: `## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`description`" + ` - (Optional) Description of the example.
+* ` + "`name`" + ` - (Required) Name.
+  Must be unique.
+* ` + "`tags`" + ` - (Optional) Tags.
+
+## Attribute Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`id`" + ` - ID.
+* ` + "`tags_all`" + ` - Tags.
+`,
+		},
+		"removed and notes": {
+			content: `## Argument Reference
+
+* ` + "`removed`" + ` - (Optional) Removed.
+* ` + "`name`" + ` - (Required) Name.
+
+~> **NOTE:** Names are case-sensitive.
+
+* ` + "`tags`" + ` - (Optional) Tags.
+* ` + "`description`" + ` - (Optional) Description.
+
+Trailing paragraph.
+
+## Attribute Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`tags_all`" + ` - Tags.
+`,
+			expected: `## Argument Reference
+
+* ` + "`description`" + ` - (Optional) Description.
+* ` + "`name`" + ` - (Required) Name.
+
+~> **NOTE:** Names are case-sensitive.
+
+* ` + "`tags`" + ` - (Optional) Tags.
+
+Trailing paragraph.
+
+## Attribute Reference
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`tags_all`" + ` - Tags.
+`,
+		},
+		"no bullets": {
+			content: `## Argument Reference
+
+This resource does not support any arguments.
+`,
+			expected: `## Argument Reference
+
+This resource does not support any arguments.
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := regenerate(testCase.content, doc)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBulletText(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute attributeDoc
+		argument  bool
+		expected  string
+	}{
+		"no text": {
+			attribute: attributeDoc{Name: "name", Required: true},
+			argument:  true,
+		},
+		"attribute": {
+			attribute: attributeDoc{Name: "arn", Computed: true, Description: "ARN of the example"},
+			expected:  "ARN of the example.",
+		},
+		"required argument": {
+			attribute: attributeDoc{Name: "name", Required: true, Description: "name of the example."},
+			argument:  true,
+			expected:  "(Required) Name of the example.",
+		},
+		"optional argument": {
+			attribute: attributeDoc{
+				Name:        "mode",
+				Optional:    true,
+				WriteOnly:   true,
+				Deprecated:  true,
+				Description: "Mode",
+				Validators:  []string{`value must be one of: ["a" "b"]`},
+				Default:     "a",
+			},
+			argument: true,
+			expected: "(Optional, Write-Only, **Deprecated**) Mode. Value must be one of: [\"a\" \"b\"]. Defaults to `a`.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := bulletText(testCase.attribute, testCase.argument), testCase.expected; got != want {
+				t.Errorf("bulletText() = %q, want %q", got, want)
+			}
+		})
+	}
+}
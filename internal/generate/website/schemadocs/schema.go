@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	identityschema "github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+)
+
+// attributeDoc is the documentation-relevant information about a top-level schema attribute or block.
+type attributeDoc struct {
+	Name          string
+	Required      bool
+	Optional      bool
+	Computed      bool
+	Sensitive     bool
+	WriteOnly     bool
+	Deprecated    bool
+	Block         bool
+	Description   string
+	Default       string
+	Validators    []string
+	PlanModifiers []string
+}
+
+// IsArgument returns whether the attribute is configurable and so belongs in the Argument Reference.
+func (a attributeDoc) IsArgument() bool {
+	return a.Required || a.Optional
+}
+
+type identityAttributeDoc struct {
+	Name        string
+	Required    bool
+	Description string
+}
+
+type schemaDoc struct {
+	TypeName   string
+	DataSource bool
+	Attributes []attributeDoc
+	Identity   []identityAttributeDoc
+}
+
+func (s schemaDoc) attribute(name string) (attributeDoc, bool) {
+	i := slices.IndexFunc(s.Attributes, func(a attributeDoc) bool {
+		return a.Name == name
+	})
+	if i == -1 {
+		return attributeDoc{}, false
+	}
+	return s.Attributes[i], true
+}
+
+// Attributes documented in their own sections rather than in the Argument or Attribute References.
+var excludedAttributes = []string{
+	"timeouts",
+}
+
+// loadSchemas instantiates the provider and returns the schemas of all registered resources and data sources.
+func loadSchemas(ctx context.Context) ([]schemaDoc, error) {
+	primary, err := sdkv2.NewProvider(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating SDKv2 provider: %w", err)
+	}
+
+	secondary, err := framework.NewProvider(ctx, primary)
+	if err != nil {
+		return nil, fmt.Errorf("creating Framework provider: %w", err)
+	}
+
+	var docs []schemaDoc
+
+	for typeName, r := range primary.ResourcesMap {
+		docs = append(docs, sdkSchemaDoc(typeName, false, r))
+	}
+	for typeName, r := range primary.DataSourcesMap {
+		docs = append(docs, sdkSchemaDoc(typeName, true, r))
+	}
+
+	for _, f := range secondary.Resources(ctx) {
+		doc, err := frameworkResourceSchemaDoc(ctx, f())
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	for _, f := range secondary.DataSources(ctx) {
+		doc, err := frameworkDataSourceSchemaDoc(ctx, f())
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	slices.SortFunc(docs, func(a, b schemaDoc) int {
+		return cmp.Or(cmp.Compare(a.TypeName, b.TypeName), compareBool(a.DataSource, b.DataSource))
+	})
+
+	return docs, nil
+}
+
+func sdkSchemaDoc(typeName string, dataSource bool, r *schema.Resource) schemaDoc {
+	doc := schemaDoc{
+		TypeName:   typeName,
+		DataSource: dataSource,
+	}
+
+	for name, s := range r.SchemaMap() {
+		a := attributeDoc{
+			Name:        name,
+			Required:    s.Required,
+			Optional:    s.Optional,
+			Computed:    s.Computed,
+			Sensitive:   s.Sensitive,
+			WriteOnly:   s.WriteOnly,
+			Deprecated:  s.Deprecated != "",
+			Description: s.Description,
+		}
+		if _, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			a.Block = true
+		}
+		if s.Default != nil {
+			a.Default = fmt.Sprintf("%v", s.Default)
+		}
+		if s.ForceNew {
+			a.PlanModifiers = append(a.PlanModifiers, "If the value of this attribute changes, Terraform will destroy and recreate the resource.")
+		}
+
+		doc.Attributes = append(doc.Attributes, a)
+	}
+
+	if r.Identity != nil && r.Identity.SchemaFunc != nil {
+		for name, s := range r.Identity.SchemaFunc() {
+			doc.Identity = append(doc.Identity, identityAttributeDoc{
+				Name:        name,
+				Required:    s.RequiredForImport,
+				Description: s.Description,
+			})
+		}
+	}
+
+	doc.normalize()
+
+	return doc
+}
+
+func frameworkResourceSchemaDoc(ctx context.Context, r resource.Resource) (schemaDoc, error) {
+	var metadataResponse resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+
+	doc := schemaDoc{
+		TypeName: metadataResponse.TypeName,
+	}
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		return doc, fmt.Errorf("reading %s resource schema: %v", doc.TypeName, schemaResponse.Diagnostics.Errors())
+	}
+
+	for name, a := range schemaResponse.Schema.Attributes {
+		doc.Attributes = append(doc.Attributes, frameworkAttributeDoc(ctx, name, a))
+	}
+	for name, b := range schemaResponse.Schema.Blocks {
+		doc.Attributes = append(doc.Attributes, frameworkBlockDoc(ctx, name, b))
+	}
+
+	if v, ok := r.(resource.ResourceWithIdentity); ok {
+		var identitySchemaResponse resource.IdentitySchemaResponse
+		v.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResponse)
+
+		for name, a := range identitySchemaResponse.IdentitySchema.Attributes {
+			doc.Identity = append(doc.Identity, frameworkIdentityAttributeDoc(name, a))
+		}
+	}
+
+	doc.normalize()
+
+	return doc, nil
+}
+
+func frameworkDataSourceSchemaDoc(ctx context.Context, d datasource.DataSource) (schemaDoc, error) {
+	var metadataResponse datasource.MetadataResponse
+	d.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+
+	doc := schemaDoc{
+		TypeName:   metadataResponse.TypeName,
+		DataSource: true,
+	}
+
+	var schemaResponse datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		return doc, fmt.Errorf("reading %s data source schema: %v", doc.TypeName, schemaResponse.Diagnostics.Errors())
+	}
+
+	for name, a := range schemaResponse.Schema.Attributes {
+		doc.Attributes = append(doc.Attributes, frameworkAttributeDoc(ctx, name, a))
+	}
+	for name, b := range schemaResponse.Schema.Blocks {
+		doc.Attributes = append(doc.Attributes, frameworkBlockDoc(ctx, name, b))
+	}
+
+	doc.normalize()
+
+	return doc, nil
+}
+
+// frameworkAttribute is the subset of the Plugin Framework's internal schema attribute interface
+// implemented by both resource and data source schema attributes.
+type frameworkAttribute interface {
+	GetDeprecationMessage() string
+	GetDescription() string
+	GetMarkdownDescription() string
+	IsComputed() bool
+	IsOptional() bool
+	IsRequired() bool
+	IsSensitive() bool
+	IsWriteOnly() bool
+}
+
+var (
+	_ frameworkAttribute = resourceschema.Attribute(nil)
+	_ frameworkAttribute = datasourceschema.Attribute(nil)
+)
+
+func frameworkAttributeDoc(ctx context.Context, name string, a frameworkAttribute) attributeDoc {
+	doc := attributeDoc{
+		Name:        name,
+		Required:    a.IsRequired(),
+		Optional:    a.IsOptional(),
+		Computed:    a.IsComputed(),
+		Sensitive:   a.IsSensitive(),
+		WriteOnly:   a.IsWriteOnly(),
+		Deprecated:  a.GetDeprecationMessage() != "",
+		Description: cmp.Or(a.GetMarkdownDescription(), a.GetDescription()),
+	}
+
+	// Validators, plan modifiers and defaults are typed per attribute type, e.g. []validator.String,
+	// so are read via reflection on the well-known field names.
+	v := reflect.Indirect(reflect.ValueOf(a))
+	if v.Kind() != reflect.Struct {
+		return doc
+	}
+
+	doc.Validators = describeAll(ctx, v.FieldByName("Validators"))
+	doc.PlanModifiers = describeAll(ctx, v.FieldByName("PlanModifiers"))
+
+	if f := v.FieldByName("Default"); f.IsValid() && !f.IsNil() {
+		doc.Default = defaultValue(ctx, f.Interface())
+	}
+
+	return doc
+}
+
+// frameworkBlock is the subset of the Plugin Framework's internal schema block interface
+// implemented by both resource and data source schema blocks.
+type frameworkBlock interface {
+	GetDeprecationMessage() string
+	GetDescription() string
+	GetMarkdownDescription() string
+}
+
+// Blocks are Optional in the schema, with any requirement expressed via an `IsRequired()` validator.
+var requiredBlockValidatorTypes = []reflect.Type{
+	reflect.TypeOf(listvalidator.IsRequired()),
+	reflect.TypeOf(objectvalidator.IsRequired()),
+	reflect.TypeOf(setvalidator.IsRequired()),
+}
+
+func frameworkBlockDoc(ctx context.Context, name string, b frameworkBlock) attributeDoc {
+	doc := attributeDoc{
+		Name:        name,
+		Optional:    true,
+		Deprecated:  b.GetDeprecationMessage() != "",
+		Block:       true,
+		Description: cmp.Or(b.GetMarkdownDescription(), b.GetDescription()),
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(b))
+	if v.Kind() != reflect.Struct {
+		return doc
+	}
+
+	validators := v.FieldByName("Validators")
+	if validators.IsValid() && validators.Kind() == reflect.Slice {
+		for i := range validators.Len() {
+			if isRequiredBlockValidator(validators.Index(i)) {
+				doc.Required, doc.Optional = true, false
+			}
+		}
+	}
+
+	doc.Validators = describeAll(ctx, validators)
+	doc.PlanModifiers = describeAll(ctx, v.FieldByName("PlanModifiers"))
+
+	return doc
+}
+
+func frameworkIdentityAttributeDoc(name string, a identityschema.Attribute) identityAttributeDoc {
+	return identityAttributeDoc{
+		Name:        name,
+		Required:    a.IsRequiredForImport(),
+		Description: cmp.Or(a.GetMarkdownDescription(), a.GetDescription()),
+	}
+}
+
+// isRequiredBlockValidator returns whether the specified validator is a block's `IsRequired()` validator.
+func isRequiredBlockValidator(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+
+	return slices.Contains(requiredBlockValidatorTypes, v.Type())
+}
+
+type describer interface {
+	Description(context.Context) string
+}
+
+// describeAll returns the descriptions of the elements of a slice of validators or plan modifiers.
+func describeAll(ctx context.Context, v reflect.Value) []string {
+	if !v.IsValid() || v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	var descriptions []string
+	for i := range v.Len() {
+		// A block's requirement is documented by its (Required) flag.
+		if isRequiredBlockValidator(v.Index(i)) {
+			continue
+		}
+		if d, ok := v.Index(i).Interface().(describer); ok {
+			if s := strings.TrimSpace(d.Description(ctx)); s != "" {
+				descriptions = append(descriptions, s)
+			}
+		}
+	}
+
+	return descriptions
+}
+
+// defaultValue returns the string representation of a static default value, or "" if the default is not a primitive.
+func defaultValue(ctx context.Context, v any) string {
+	var value attr.Value
+
+	switch v := v.(type) {
+	case defaults.Bool:
+		var response defaults.BoolResponse
+		v.DefaultBool(ctx, defaults.BoolRequest{}, &response)
+		value = response.PlanValue
+	case defaults.Float64:
+		var response defaults.Float64Response
+		v.DefaultFloat64(ctx, defaults.Float64Request{}, &response)
+		value = response.PlanValue
+	case defaults.Int32:
+		var response defaults.Int32Response
+		v.DefaultInt32(ctx, defaults.Int32Request{}, &response)
+		value = response.PlanValue
+	case defaults.Int64:
+		var response defaults.Int64Response
+		v.DefaultInt64(ctx, defaults.Int64Request{}, &response)
+		value = response.PlanValue
+	case defaults.String:
+		var response defaults.StringResponse
+		v.DefaultString(ctx, defaults.StringRequest{}, &response)
+		value = response.PlanValue
+	default:
+		return ""
+	}
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+
+	if v, ok := value.(basetypes.StringValue); ok {
+		return v.ValueString()
+	}
+
+	return value.String()
+}
+
+// normalize removes excluded attributes and sorts attributes by name.
+func (s *schemaDoc) normalize() {
+	s.Attributes = slices.DeleteFunc(s.Attributes, func(a attributeDoc) bool {
+		return slices.Contains(excludedAttributes, a.Name)
+	})
+	slices.SortFunc(s.Attributes, func(a, b attributeDoc) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(s.Identity, func(a, b identityAttributeDoc) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestFrameworkBlockDoc(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    frameworkBlock
+		expected attributeDoc
+	}{
+		"optional list": {
+			block: schema.ListNestedBlock{
+				Description: "Configuration",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			expected: attributeDoc{
+				Name:        "block",
+				Optional:    true,
+				Block:       true,
+				Description: "Configuration",
+				Validators:  []string{"list must contain at most 1 elements"},
+			},
+		},
+		"required list": {
+			block: schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			expected: attributeDoc{
+				Name:       "block",
+				Required:   true,
+				Block:      true,
+				Validators: []string{"list must contain at most 1 elements"},
+			},
+		},
+		"required set": {
+			block: schema.SetNestedBlock{
+				DeprecationMessage: "Use something else",
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+				},
+			},
+			expected: attributeDoc{
+				Name:       "block",
+				Required:   true,
+				Deprecated: true,
+				Block:      true,
+			},
+		},
+		"required single": {
+			block: schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+			},
+			expected: attributeDoc{
+				Name:     "block",
+				Required: true,
+				Block:    true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkBlockDoc(t.Context(), "block", testCase.block)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
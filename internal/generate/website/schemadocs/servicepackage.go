@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+)
+
+// servicePackageDocFilenames returns the documentation file paths of the resources and data sources registered
+// in the specified service package's generated service_package_gen.go file.
+// src is passed to go/parser and may be nil to read the file from disk.
+func servicePackageDocFilenames(filename string, src any) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	var filenames []string
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || funcDecl.Body == nil {
+			continue
+		}
+
+		var dataSource bool
+		switch funcDecl.Name.Name {
+		case "FrameworkResources", "SDKResources":
+		case "FrameworkDataSources", "SDKDataSources":
+			dataSource = true
+		default:
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+
+			if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "TypeName" {
+				return true
+			}
+
+			if lit, ok := kv.Value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if typeName, err := strconv.Unquote(lit.Value); err == nil {
+					filenames = append(filenames, docFilename(schemaDoc{TypeName: typeName, DataSource: dataSource}))
+				}
+			}
+
+			return false
+		})
+	}
+
+	return filenames, nil
+}
+
+// servicePackageGenFilename returns the path of a service package's generated service_package_gen.go file.
+func servicePackageGenFilename(servicePackage string) string {
+	return filepath.Join(*servicePackagesRoot, servicePackage, "service_package_gen.go")
+}
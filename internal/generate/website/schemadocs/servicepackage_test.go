@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServicePackageDocFilenames(t *testing.T) {
+	t.Parallel()
+
+	const src = `package logs
+
+type servicePackage struct{}
+
+func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
+	return []*inttypes.ServicePackageFrameworkDataSource{}
+}
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newDeliveryResource,
+			TypeName: "aws_cloudwatch_log_delivery",
+			Name:     "Delivery",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+		},
+	}
+}
+
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newTokenEphemeralResource,
+			TypeName: "aws_cloudwatch_log_token",
+		},
+	}
+}
+
+func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
+	return []*inttypes.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceGroup,
+			TypeName: "aws_cloudwatch_log_group",
+			Name:     "Log Group",
+		},
+	}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourceGroup,
+			TypeName: "aws_cloudwatch_log_group",
+			Name:     "Log Group",
+		},
+		{
+			Factory:  resourceMetricFilter,
+			TypeName: "aws_cloudwatch_log_metric_filter",
+			Name:     "Metric Filter",
+		},
+	}
+}
+
+func (p *servicePackage) ServicePackageName() string {
+	return names.Logs
+}
+`
+
+	got, err := servicePackageDocFilenames("service_package_gen.go", src)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		"website/docs/r/cloudwatch_log_delivery.html.markdown",
+		"website/docs/d/cloudwatch_log_group.html.markdown",
+		"website/docs/r/cloudwatch_log_group.html.markdown",
+		"website/docs/r/cloudwatch_log_metric_filter.html.markdown",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
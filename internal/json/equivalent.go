@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// The functions in this file return whether the JSON documents in the given strings are equivalent
+// to the AWS service that owns them.
+// Documents are decoded before comparison, so key order, whitespace, escaping and numeric formatting,
+// e.g. `2` and `2.0`, are not significant.
+
+// EventPatternsEquivalent returns whether the EventBridge event patterns in the given strings are equivalent.
+// As a match value array matches any of its elements, the order of elements and any duplicates are not significant.
+// The order of elements of a content filter's array, e.g. `{"numeric": [">", 0, "<=", 5]}`, is significant.
+func EventPatternsEquivalent(s1, s2 string) bool {
+	return equivalentStrings(s1, s2, normalizeEventPattern)
+}
+
+// StateMachineDefinitionsEquivalent returns whether the Step Functions Amazon States Language definitions in the given strings are equivalent.
+// Fields set to their Amazon States Language default value, e.g. a retrier's `"MaxAttempts": 3`, are not significant.
+func StateMachineDefinitionsEquivalent(s1, s2 string) bool {
+	return equivalentStrings(s1, s2, normalizeStateMachineDefinition)
+}
+
+// DashboardBodiesEquivalent returns whether the CloudWatch dashboard bodies in the given strings are equivalent.
+// Widget fields and metric widget properties set to their default value, e.g. `"width": 6`, are not significant.
+func DashboardBodiesEquivalent(s1, s2 string) bool {
+	return equivalentStrings(s1, s2, normalizeDashboardBody)
+}
+
+func equivalentStrings(s1, s2 string, normalize func(any) any) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	var v1 any
+	if err := DecodeFromString(s1, &v1); err != nil {
+		return false
+	}
+
+	var v2 any
+	if err := DecodeFromString(s2, &v2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalize(v1), normalize(v2))
+}
+
+// normalizeEventPattern normalizes a pattern object, whose fields' values are nested pattern objects or match value arrays.
+func normalizeEventPattern(v any) any {
+	pattern, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	for k, v := range pattern {
+		switch v := v.(type) {
+		case map[string]any:
+			pattern[k] = normalizeEventPattern(v)
+		case []any:
+			if k == "$or" {
+				// Each element is a pattern object.
+				for i, e := range v {
+					v[i] = normalizeEventPattern(e)
+				}
+			} else {
+				pattern[k] = normalizeMatchValues(v)
+			}
+		}
+	}
+
+	return pattern
+}
+
+// normalizeMatchValues normalizes a match value array.
+// As the array matches any of its elements, the order of elements and any duplicates are not significant.
+// Elements that are content filters, e.g. `{"numeric": [">", 0, "<=", 5]}`, are compared as is,
+// as the order of the operands of a filter is significant.
+func normalizeMatchValues(v []any) any {
+	type element struct {
+		key   string
+		value any
+	}
+	var elements []element
+	for _, e := range v {
+		b, err := json.Marshal(e) // Map keys are sorted.
+		if err != nil {
+			return v
+		}
+		elements = append(elements, element{key: string(b), value: e})
+	}
+	slices.SortFunc(elements, func(a, b element) int {
+		return strings.Compare(a.key, b.key)
+	})
+	elements = slices.CompactFunc(elements, func(a, b element) bool {
+		return a.key == b.key
+	})
+	result := make([]any, 0, len(elements))
+	for _, e := range elements {
+		result = append(result, e.value)
+	}
+	return result
+}
+
+const (
+	queryLanguageJSONPath = "JSONPath"
+)
+
+func normalizeStateMachineDefinition(v any) any {
+	definition, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	normalizeStateMachine(definition, queryLanguageJSONPath)
+
+	return definition
+}
+
+// normalizeStateMachine normalizes a state machine, or a Parallel state branch or Map state processor,
+// whose states inherit the specified query language unless it is overridden.
+func normalizeStateMachine(m map[string]any, queryLanguage string) {
+	if v, ok := m["QueryLanguage"].(string); ok {
+		if v == queryLanguage {
+			delete(m, "QueryLanguage")
+		} else {
+			queryLanguage = v
+		}
+	}
+
+	states, ok := m["States"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, v := range states {
+		if state, ok := v.(map[string]any); ok {
+			normalizeState(state, queryLanguage)
+		}
+	}
+}
+
+func normalizeState(m map[string]any, queryLanguage string) {
+	if v, ok := m["QueryLanguage"].(string); ok {
+		if v == queryLanguage {
+			delete(m, "QueryLanguage")
+		} else {
+			queryLanguage = v
+		}
+	}
+
+	if retriers, ok := m["Retry"].([]any); ok {
+		for _, v := range retriers {
+			if retrier, ok := v.(map[string]any); ok {
+				deleteIfEqual(retrier, "BackoffRate", float64(2))
+				deleteIfEqual(retrier, "IntervalSeconds", float64(1))
+				deleteIfEqual(retrier, "JitterStrategy", "NONE")
+				deleteIfEqual(retrier, "MaxAttempts", float64(3))
+			}
+		}
+	}
+
+	switch m["Type"] {
+	case "Parallel":
+		if branches, ok := m["Branches"].([]any); ok {
+			for _, v := range branches {
+				if branch, ok := v.(map[string]any); ok {
+					normalizeStateMachine(branch, queryLanguage)
+				}
+			}
+		}
+	case "Map":
+		for _, k := range []string{"ItemProcessor", "Iterator"} {
+			if processor, ok := m[k].(map[string]any); ok {
+				if config, ok := processor["ProcessorConfig"].(map[string]any); ok {
+					deleteIfEqual(config, "Mode", "INLINE")
+					if len(config) == 0 {
+						delete(processor, "ProcessorConfig")
+					}
+				}
+				normalizeStateMachine(processor, queryLanguage)
+			}
+		}
+	}
+}
+
+func normalizeDashboardBody(v any) any {
+	body, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	deleteIfEqual(body, "periodOverride", "auto")
+
+	widgets, ok := body["widgets"].([]any)
+	if !ok {
+		return body
+	}
+
+	for _, v := range widgets {
+		widget, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		deleteIfEqual(widget, "height", float64(6))
+		deleteIfEqual(widget, "width", float64(6))
+
+		if widget["type"] != "metric" {
+			continue
+		}
+
+		if properties, ok := widget["properties"].(map[string]any); ok {
+			deleteIfEqual(properties, "period", float64(300))
+			deleteIfEqual(properties, "stacked", false)
+			deleteIfEqual(properties, "stat", "Average")
+			deleteIfEqual(properties, "view", "timeSeries")
+		}
+	}
+
+	return body
+}
+
+// deleteIfEqual deletes the specified key from the map if its value is the specified comparable value.
+func deleteIfEqual(m map[string]any, key string, value any) {
+	if v, ok := m[key]; ok && v == value {
+		delete(m, key)
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestEventPatternsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName  string
+		x, y      string
+		wantEqual bool
+	}{
+		{
+			testName: "invalid JSON",
+			x:        `test`,
+			y:        `{}`,
+		},
+		{
+			testName:  "key order and whitespace",
+			x:         `{"source": ["aws.ec2"], "detail-type": ["EC2 Instance State-change Notification"]}`,
+			y:         `{"detail-type":["EC2 Instance State-change Notification"],"source":["aws.ec2"]}`,
+			wantEqual: true,
+		},
+		{
+			testName:  "escaped characters",
+			x:         `{"detail": {"name": ["a<b&c"]}}`,
+			y:         `{"detail": {"name": ["a<b&c"]}}`,
+			wantEqual: true,
+		},
+		{
+			testName:  "array element order",
+			x:         `{"source": ["aws.ec2", "aws.s3"], "detail": {"state": [{"prefix": "run"}, "stopped"]}}`,
+			y:         `{"source": ["aws.s3", "aws.ec2"], "detail": {"state": ["stopped", {"prefix": "run"}]}}`,
+			wantEqual: true,
+		},
+		{
+			testName:  "numeric formatting and duplicates",
+			x:         `{"detail": {"count": [{"numeric": [">", 0, "<=", 5]}], "code": [1, 1]}}`,
+			y:         `{"detail": {"count": [{"numeric": [">", 0.0, "<=", 5.0]}], "code": [1.0]}}`,
+			wantEqual: true,
+		},
+		{
+			testName:  "$or element order",
+			x:         `{"$or": [{"source": ["aws.ec2"]}, {"detail": {"state": ["stopped", "running"]}}]}`,
+			y:         `{"$or": [{"source": ["aws.ec2"]}, {"detail": {"state": ["running", "stopped"]}}]}`,
+			wantEqual: true,
+		},
+		{
+			testName: "numeric operand order",
+			x:        `{"detail": {"x": [{"numeric": [">", 0, "<=", 5]}]}}`,
+			y:        `{"detail": {"x": [{"numeric": [">", 5, "<=", 0]}]}}`,
+		},
+		{
+			testName: "numeric operator order",
+			x:        `{"detail": {"x": [{"numeric": [">", 0, "<=", 5]}]}}`,
+			y:        `{"detail": {"x": [{"numeric": ["<=", 5, ">", 0]}]}}`,
+		},
+		{
+			testName: "anything-but element order",
+			x:        `{"detail": {"state": [{"anything-but": ["stopped", "running"]}]}}`,
+			y:        `{"detail": {"state": [{"anything-but": ["running", "stopped"]}]}}`,
+		},
+		{
+			testName: "anything-but prefix element order",
+			x:        `{"detail": {"state": [{"anything-but": {"prefix": ["a", "b"]}}]}}`,
+			y:        `{"detail": {"state": [{"anything-but": {"prefix": ["b", "a"]}}]}}`,
+		},
+		{
+			testName: "anything-but suffix element order",
+			x:        `{"detail": {"key": [{"anything-but": {"suffix": [".png", ".jpg"]}}]}}`,
+			y:        `{"detail": {"key": [{"anything-but": {"suffix": [".jpg", ".png"]}}]}}`,
+		},
+		{
+			testName: "different values",
+			x:        `{"source": ["aws.ec2"]}`,
+			y:        `{"source": ["aws.s3"]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := json.EventPatternsEquivalent(testCase.x, testCase.y), testCase.wantEqual; got != want {
+				t.Errorf("EventPatternsEquivalent(%q, %q) = %t, want %t", testCase.x, testCase.y, got, want)
+			}
+		})
+	}
+}
+
+func TestStateMachineDefinitionsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName  string
+		x, y      string
+		wantEqual bool
+	}{
+		{
+			testName: "invalid JSON",
+			x:        `{"StartAt": "A"`,
+			y:        `{"StartAt": "A"}`,
+		},
+		{
+			testName: "default values",
+			x: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:123456789012:function:test",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "IntervalSeconds": 1, "MaxAttempts": 3, "BackoffRate": 2.0}],
+      "End": true
+    }
+  }
+}`,
+			y: `{
+  "QueryLanguage": "JSONPath",
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:123456789012:function:test",
+      "Retry": [{"ErrorEquals": ["States.ALL"]}],
+      "End": true
+    }
+  }
+}`,
+			wantEqual: true,
+		},
+		{
+			testName: "nested state machines",
+			x: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "P",
+  "States": {
+    "P": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "QueryLanguage": "JSONata", "End": true}}}],
+      "Next": "M"
+    },
+    "M": {
+      "Type": "Map",
+      "ItemProcessor": {
+        "ProcessorConfig": {"Mode": "INLINE"},
+        "StartAt": "B",
+        "States": {"B": {"Type": "Pass", "End": true}}
+      },
+      "End": true
+    }
+  }
+}`,
+			y: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "P",
+  "States": {
+    "P": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}],
+      "Next": "M"
+    },
+    "M": {
+      "Type": "Map",
+      "ItemProcessor": {
+        "StartAt": "B",
+        "States": {"B": {"Type": "Pass", "End": true}}
+      },
+      "End": true
+    }
+  }
+}`,
+			wantEqual: true,
+		},
+		{
+			testName: "overridden query language",
+			x:        `{"StartAt": "A", "States": {"A": {"Type": "Pass", "QueryLanguage": "JSONata", "End": true}}}`,
+			y:        `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}`,
+		},
+		{
+			testName: "non-default values",
+			x:        `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "r", "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 5}], "End": true}}}`,
+			y:        `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "r", "Retry": [{"ErrorEquals": ["States.ALL"]}], "End": true}}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := json.StateMachineDefinitionsEquivalent(testCase.x, testCase.y), testCase.wantEqual; got != want {
+				t.Errorf("StateMachineDefinitionsEquivalent(%q, %q) = %t, want %t", testCase.x, testCase.y, got, want)
+			}
+		})
+	}
+}
+
+func TestDashboardBodiesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName  string
+		x, y      string
+		wantEqual bool
+	}{
+		{
+			testName: "invalid JSON",
+			x:        `{"widgets": []`,
+			y:        `{"widgets": []}`,
+		},
+		{
+			testName: "default values",
+			x: `{
+  "widgets": [{
+    "type": "metric",
+    "x": 0,
+    "y": 0,
+    "properties": {
+      "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId", "i-012345"]],
+      "region": "us-east-1",
+      "title": "EC2 Instance CPU"
+    }
+  }]
+}`,
+			y: `{
+  "periodOverride": "auto",
+  "widgets": [{
+    "type": "metric",
+    "x": 0,
+    "y": 0,
+    "width": 6,
+    "height": 6.0,
+    "properties": {
+      "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId", "i-012345"]],
+      "period": 300,
+      "region": "us-east-1",
+      "stacked": false,
+      "stat": "Average",
+      "title": "EC2 Instance CPU",
+      "view": "timeSeries"
+    }
+  }]
+}`,
+			wantEqual: true,
+		},
+		{
+			testName: "non-default values",
+			x:        `{"widgets": [{"type": "metric", "x": 0, "y": 0, "properties": {"metrics": [], "stat": "Maximum"}}]}`,
+			y:        `{"widgets": [{"type": "metric", "x": 0, "y": 0, "properties": {"metrics": []}}]}`,
+		},
+		{
+			testName: "widget order",
+			x:        `{"widgets": [{"type": "text", "x": 0, "y": 0, "properties": {"markdown": "a"}}, {"type": "text", "x": 6, "y": 0, "properties": {"markdown": "b"}}]}`,
+			y:        `{"widgets": [{"type": "text", "x": 6, "y": 0, "properties": {"markdown": "b"}}, {"type": "text", "x": 0, "y": 0, "properties": {"markdown": "a"}}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := json.DashboardBodiesEquivalent(testCase.x, testCase.y), testCase.wantEqual; got != want {
+				t.Errorf("DashboardBodiesEquivalent(%q, %q) = %t, want %t", testCase.x, testCase.y, got, want)
+			}
+		})
+	}
+}
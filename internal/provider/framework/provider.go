@@ -207,6 +207,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_with_aws": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate supported resource arguments, such as IAM policy documents, using AWS validation APIs when planning.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -95,6 +95,7 @@ func (h HTags) ListTags(ctx context.Context, sp conns.ServicePackage, c taggingA
 		err = nil
 	case sp.ServicePackageName() == names.DynamoDB && err != nil:
 		// When a DynamoDB Table is `ARCHIVED`, ListTags returns `ResourceNotFoundException`.
+		//lintignore:AWSR004
 		if tfresource.NotFound(err) || tfawserr.ErrMessageContains(err, "UnknownOperationException", "Tagging is not currently supported in DynamoDB Local.") {
 			err = nil
 		}
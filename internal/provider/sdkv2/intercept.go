@@ -267,6 +267,11 @@ func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorIn
 			}
 		}
 
+		// A single error is returned as is so that any attribute path, e.g. from a cty.PathError, is reported in the diagnostic.
+		if len(errs) == 1 {
+			return errs[0]
+		}
+
 		return errors.Join(errs...)
 	}
 }
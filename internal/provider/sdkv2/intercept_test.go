@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -411,9 +412,15 @@ func TestInterceptedCustomizeDiffHandler(t *testing.T) {
 			expectedFirstCalls:  []when{Before, OnError, Finally},
 			expectedSecondCalls: []when{Before, OnError, Finally},
 			expectedInnerCalls:  1,
-			expectedError: errors.Join(
-				errors.New("Inner function error"),
-			),
+			expectedError:       errors.New("Inner function error"),
+		},
+
+		"Inner has path error": {
+			innerFuncError:      cty.GetAttrPath("attr").NewError(errors.New("Inner function error")),
+			expectedFirstCalls:  []when{Before, OnError, Finally},
+			expectedSecondCalls: []when{Before, OnError, Finally},
+			expectedInnerCalls:  1,
+			expectedError:       cty.GetAttrPath("attr").NewError(errors.New("Inner function error")),
 		},
 
 		"All have errors": {
@@ -478,7 +485,9 @@ func TestInterceptedCustomizeDiffHandler(t *testing.T) {
 			err := handler(ctx, nil, client)
 
 			if diff := cmp.Diff(err, tc.expectedError, cmp.Comparer(func(x, y error) bool {
-				return x.Error() == y.Error()
+				xPathErr, xOK := errs.As[cty.PathError](x)
+				yPathErr, yOK := errs.As[cty.PathError](y)
+				return x.Error() == y.Error() && xOK == yOK && xPathErr.Path.Equals(yPathErr.Path)
 			})); diff != "" {
 				t.Errorf("unexpected error difference: %s", diff)
 			}
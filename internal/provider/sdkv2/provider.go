@@ -270,6 +270,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:    true,
 					Description: "Resolve an endpoint with FIPS capability",
 				},
+				"validate_with_aws": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Validate supported resource arguments, such as IAM policy documents, " +
+						"using AWS validation APIs when planning.",
+				},
 			},
 
 			// Data sources and resources implemented using Terraform Plugin SDK
@@ -354,6 +360,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		ValidateWithAWS:                d.Get("validate_with_aws").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -39,7 +39,7 @@ func (op opFunc[T]) UntilFoundN(continuousTargetOccurence int) runFunc[T] {
 			return true, nil
 		}
 
-		if NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		if NotFound(err) { //lintignore:AWSR004
 			targetOccurence = 0
 
 			return true, err
@@ -57,7 +57,7 @@ func (op opFunc[T]) UntilNotFound() runFunc[T] {
 			return true, nil
 		}
 
-		if NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		if NotFound(err) { //lintignore:AWSR004
 			return false, nil
 		}
 
@@ -91,8 +91,11 @@ func (op opFunc[T]) If(predicate predicateFunc[T]) runFunc[T] {
 			t   T
 			err error
 		)
+		// Progress is logged unless the caller overrides the reporter.
+		opts = append([]backoff.Option{backoff.WithProgressReporter(LogProgress, defaultProgressInterval)}, opts...)
 		for l = backoff.NewLoopWithOptions(timeout, opts...); l.Continue(ctx); {
 			t, err = op(ctx)
+			l.SetStatus(errorStatus(err))
 
 			if retry, err := predicate(t, err); !retry {
 				return t, err
@@ -202,3 +202,27 @@ func TestUntilNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestUntilFoundN_progress(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	reporter := func(_ context.Context, progress backoff.Progress) {
+		got = append(got, progress.Reason)
+	}
+
+	_, err := retry.Op(UntilFoundOpFunc()).UntilFoundN(1)(t.Context(), 2*time.Minute, testBackoffOpts, backoff.WithProgressReporter(reporter, 0))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Progress is reported before each retry with the error from the previous attempt.
+	if got, want := len(got), 3; got != want {
+		t.Fatalf("Reports = %v, want %v", got, want)
+	}
+	for _, reason := range got {
+		if reason == "" {
+			t.Errorf("Reason is empty")
+		}
+	}
+}
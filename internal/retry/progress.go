@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+)
+
+const (
+	// defaultProgressInterval is how often the progress of a wait is reported by default.
+	defaultProgressInterval = 1 * time.Minute
+)
+
+// LogProgress is a backoff.ProgressReporter that logs the progress of a wait.
+// It is the default reporter for Op and StateChangeConf.
+func LogProgress(ctx context.Context, progress backoff.Progress) {
+	fields := map[string]any{
+		"attempt":   progress.Attempt,
+		"elapsed":   progress.Elapsed.Truncate(time.Second).String(),
+		"remaining": progress.Remaining.Truncate(time.Second).String(),
+	}
+	if v := progress.State; v != "" {
+		fields["state"] = v
+	}
+	if v := progress.PercentComplete; v != nil {
+		fields["percent_complete"] = *v
+	}
+	if v := progress.Reason; v != "" {
+		fields["reason"] = v
+	}
+
+	tflog.Info(ctx, "Still waiting", fields)
+}
+
+// errorStatus returns the status reported after an operation returns the specified error.
+func errorStatus(err error) backoff.Status {
+	var status backoff.Status
+
+	if err != nil {
+		status.Reason = err.Error()
+	}
+
+	return status
+}
@@ -6,9 +6,11 @@ package retry
 import (
 	"context"
 	"errors"
+	"reflect"
 	"slices"
 	"time"
 
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -43,6 +45,12 @@ type StateChangeConfOf[T any, S ~string] struct {
 	PollInterval   time.Duration            // Override MinTimeout/backoff and only poll this often
 	NotFoundChecks int                      // Number of times to allow not found (nil result from Refresh)
 
+	// Progress of long-running waits is reported periodically, by default to the log.
+	// Status returns any additional status, e.g. percentage complete or status reason, to report for the current refresh result.
+	Status           func(T) backoff.Status
+	ProgressReporter backoff.ProgressReporter // Override the default progress reporter
+	ProgressInterval time.Duration            // Override the default progress reporting interval
+
 	// This is to work around inconsistent APIs
 	ContinuousTargetOccurence int // Number of times the Target state has to occur continuously
 }
@@ -50,6 +58,25 @@ type StateChangeConfOf[T any, S ~string] struct {
 // StateChangeConf is the specialization used in all code using helper/retry.
 type StateChangeConf = StateChangeConfOf[any, string]
 
+// StateChangeConfFromSDKv2 returns the equivalent of the specified Plugin SDK V2 helper/retry StateChangeConf.
+// Waits using the returned configuration report progress, with any additional status from the specified function.
+func StateChangeConfFromSDKv2(conf *sdkretry.StateChangeConf, status func(any) backoff.Status) *StateChangeConf {
+	return &StateChangeConf{
+		Delay:   conf.Delay,
+		Pending: conf.Pending,
+		Refresh: func(context.Context) (any, string, error) {
+			return conf.Refresh()
+		},
+		Target:                    conf.Target,
+		Timeout:                   conf.Timeout,
+		MinTimeout:                conf.MinTimeout,
+		PollInterval:              conf.PollInterval,
+		NotFoundChecks:            conf.NotFoundChecks,
+		Status:                    status,
+		ContinuousTargetOccurence: conf.ContinuousTargetOccurence,
+	}
+}
+
 // WaitForState watches an object and waits for it to achieve the state
 // specified in the configuration using the specified Refresh() func,
 // waiting the number of seconds specified in the timeout configuration.
@@ -88,6 +115,14 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 		}
 	}
 
+	progressReporter, progressInterval := backoff.ProgressReporter(LogProgress), defaultProgressInterval
+	if conf.ProgressReporter != nil {
+		progressReporter = conf.ProgressReporter
+	}
+	if conf.ProgressInterval > 0 {
+		progressInterval = conf.ProgressInterval
+	}
+
 	var (
 		t                             T
 		currentState                  S
@@ -95,8 +130,9 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 		notFoundTick, targetOccurence int
 		l                             *backoff.Loop
 	)
-	for l = backoff.NewLoopWithOptions(conf.Timeout, backoff.WithDelay(delay)); l.Continue(ctx); {
+	for l = backoff.NewLoopWithOptions(conf.Timeout, backoff.WithDelay(delay), backoff.WithProgressReporter(progressReporter, progressInterval)); l.Continue(ctx); {
 		t, currentState, err = conf.refreshWithTimeout(ctx, l.Remaining())
+		l.SetStatus(conf.status(t, currentState))
 
 		if errors.Is(err, context.DeadlineExceeded) {
 			break
@@ -106,7 +142,7 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 			return t, err
 		}
 
-		if any(t) == nil {
+		if isNil(t) {
 			// If we're waiting for the absence of a thing, then return.
 			if len(conf.Target) == 0 {
 				targetOccurence++
@@ -175,6 +211,41 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	return t, context.Cause(ctx)
 }
 
+// status returns the status to report for the specified refresh result.
+func (conf *StateChangeConfOf[T, S]) status(t T, currentState S) backoff.Status {
+	var status backoff.Status
+
+	if isNil(t) {
+		status.Reason = "not found"
+		return status
+	}
+
+	if conf.Status != nil {
+		status = conf.Status(t)
+	}
+	if status.State == "" {
+		status.State = string(currentState)
+	}
+
+	return status
+}
+
+// isNil returns whether the specified refresh result is nil, including a nil pointer, map, slice or other nillable value of a non-interface type.
+func isNil[T any](t T) bool {
+	v := reflect.ValueOf(any(t))
+
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
 func (conf *StateChangeConfOf[T, S]) refreshWithTimeout(ctx context.Context, timeout time.Duration) (T, S, error) {
 	// Set a deadline on the context here to maintain compatibility with the Plugin SDKv2 implementation.
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -12,6 +12,9 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 )
 
 //
@@ -246,6 +249,33 @@ func TestWaitForState_successEmpty(t *testing.T) {
 	}
 }
 
+func TestWaitForState_successEmptyTypedNil(t *testing.T) {
+	t.Parallel()
+
+	type resource struct{}
+
+	conf := &StateChangeConfOf[*resource, string]{
+		Pending: []string{"pending", "incomplete"},
+		Target:  []string{},
+		Refresh: func(context.Context) (*resource, string, error) {
+			return nil, "", nil
+		},
+		Timeout: 200 * time.Second,
+	}
+
+	obj, err := conf.WaitForStateContext(t.Context())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if obj != nil {
+		t.Fatalf("obj should be nil")
+	}
+
+	if diff := cmp.Diff(conf.status(nil, ""), backoff.Status{Reason: "not found"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestWaitForState_failureEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -337,3 +367,83 @@ func TestWaitForStateContext_cancel(t *testing.T) {
 		t.Fatalf("Expected canceled context error, got: %s", err)
 	}
 }
+
+func TestWaitForState_progress(t *testing.T) {
+	t.Parallel()
+
+	var got []backoff.Status
+	conf := &StateChangeConf{
+		Pending:                   []string{"replicating"},
+		Target:                    []string{"done"},
+		Refresh:                   InconsistentStateRefreshFunc(),
+		Timeout:                   90 * time.Millisecond,
+		PollInterval:              10 * time.Millisecond,
+		ContinuousTargetOccurence: 3,
+		Status: func(v any) backoff.Status {
+			return backoff.Status{
+				PercentComplete: aws.Int(v.(int) * 10),
+			}
+		},
+		ProgressReporter: func(_ context.Context, progress backoff.Progress) {
+			got = append(got, progress.Status)
+		},
+		ProgressInterval: time.Nanosecond,
+	}
+
+	_, err := conf.WaitForStateContext(t.Context())
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	want := []backoff.Status{
+		{State: "done", PercentComplete: aws.Int(0)},
+		{State: "replicating", PercentComplete: aws.Int(10)},
+		{State: "done", PercentComplete: aws.Int(20)},
+		{State: "done", PercentComplete: aws.Int(30)},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStateChangeConfFromSDKv2(t *testing.T) {
+	t.Parallel()
+
+	var got []backoff.Status
+	r := NewStateGenerator([]string{"pending", "pending", "done"})
+	conf := StateChangeConfFromSDKv2(&sdkretry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (any, string, error) {
+			return r.NextState()
+		},
+		Timeout:      1 * time.Second,
+		PollInterval: time.Millisecond,
+	}, func(v any) backoff.Status {
+		return backoff.Status{
+			PercentComplete: aws.Int(v.(int) * 50),
+		}
+	})
+	conf.ProgressReporter = func(_ context.Context, progress backoff.Progress) {
+		got = append(got, progress.Status)
+	}
+	conf.ProgressInterval = time.Nanosecond
+
+	obj, err := conf.WaitForStateContext(t.Context())
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got, want := obj, any(2); got != want {
+		t.Errorf("obj = %v, want %v", got, want)
+	}
+
+	want := []backoff.Status{
+		{State: "pending", PercentComplete: aws.Int(0)},
+		{State: "pending", PercentComplete: aws.Int(50)},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
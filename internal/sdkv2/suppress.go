@@ -24,6 +24,24 @@ func SuppressEquivalentJSONDocuments(k, old, new string, _ *schema.ResourceData)
 	return json.EqualStrings(old, new)
 }
 
+// SuppressEquivalentEventPatterns provides custom difference suppression
+// for EventBridge event patterns in the given strings that are equivalent.
+func SuppressEquivalentEventPatterns(k, old, new string, _ *schema.ResourceData) bool {
+	return json.EventPatternsEquivalent(old, new)
+}
+
+// SuppressEquivalentStateMachineDefinitions provides custom difference suppression
+// for Step Functions state machine definitions in the given strings that are equivalent.
+func SuppressEquivalentStateMachineDefinitions(k, old, new string, _ *schema.ResourceData) bool {
+	return json.StateMachineDefinitionsEquivalent(old, new)
+}
+
+// SuppressEquivalentDashboardBodies provides custom difference suppression
+// for CloudWatch dashboard bodies in the given strings that are equivalent.
+func SuppressEquivalentDashboardBodies(k, old, new string, _ *schema.ResourceData) bool {
+	return json.DashboardBodiesEquivalent(old, new)
+}
+
 // SuppressEquivalentCloudWatchLogsLogGroupARN provides custom difference suppression
 // for strings that represent equal CloudWatch Logs log group ARNs.
 func SuppressEquivalentCloudWatchLogsLogGroupARN(_, old, new string, _ *schema.ResourceData) bool {
@@ -167,24 +167,14 @@ func (r *accountResource) Update(ctx context.Context, request resource.UpdateReq
 	if diff.HasChanges() {
 		conn := r.Meta().APIGatewayClient(ctx)
 
-		input := apigateway.UpdateAccountInput{}
-
-		if plan.CloudwatchRoleARN.IsNull() || plan.CloudwatchRoleARN.ValueString() == "" {
-			input.PatchOperations = []awstypes.PatchOperation{
-				{
-					Op:    awstypes.OpReplace,
-					Path:  aws.String("/cloudwatchRoleArn"),
-					Value: nil,
-				},
-			}
-		} else {
-			input.PatchOperations = []awstypes.PatchOperation{
-				{
-					Op:    awstypes.OpReplace,
-					Path:  aws.String("/cloudwatchRoleArn"),
-					Value: plan.CloudwatchRoleARN.ValueStringPointer(),
-				},
-			}
+		operations, d := flex.ExpandPatchOperations[awstypes.PatchOperation](ctx, diff)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := apigateway.UpdateAccountInput{
+			PatchOperations: operations,
 		}
 
 		output, err := tfresource.RetryGWhen(ctx, propagationTimeout,
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -106,6 +107,6 @@ type apiKeyModel struct {
 	LastUpdatedDate timetypes.RFC3339    `tfsdk:"last_updated_date"`
 	Name            types.String         `tfsdk:"name"`
 	StageKeys       fwtypes.ListOfString `tfsdk:"stage_keys"`
-	Tags            fwtypes.MapOfString  `tfsdk:"tags"`
+	Tags            tftags.Map           `tfsdk:"tags"`
 	Value           types.String         `tfsdk:"value"`
 }
@@ -104,9 +104,8 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.OpsItemSNSTopicArn = aws.String(v.(string))
 	}
 
-	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func(ctx context.Context) (*applicationinsights.CreateApplicationOutput, error) {
-		return conn.CreateApplication(ctx, input)
-	})
+	output, err := conn.CreateApplication(ctx, input)
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating ApplicationInsights Application: %s", err)
 	}
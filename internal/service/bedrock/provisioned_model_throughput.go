@@ -36,8 +36,11 @@ import (
 // @FrameworkResource("aws_bedrock_provisioned_model_throughput", name="Provisioned Model Throughput")
 // @Tags(identifierAttribute="provisioned_model_arn")
 // @ArnIdentity(identityDuplicateAttributes="id")
-// Testing is cost-prohibitive
-// @Testing(tagsTest=false, identityTest=false)
+// Testing is cost-prohibitive, so generated tests are skipped in testAccPreCheckProvisionedModelThroughput.
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrock;bedrock.GetProvisionedModelThroughputOutput")
+// @Testing(preCheck="testAccPreCheckProvisionedModelThroughput")
+// @Testing(preIdentityVersion="v5.100.0")
 func newProvisionedModelThroughputResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &provisionedModelThroughputResource{}
 
@@ -0,0 +1,29 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  region = var.region
+
+  provisioned_model_name = var.rName
+  model_arn              = data.aws_bedrock_foundation_model.test.model_arn
+  commitment_duration    = "OneMonth"
+  model_units            = 1
+}
+
+data "aws_bedrock_foundation_model" "test" {
+  region = var.region
+
+  model_id = "amazon.titan-text-express-v1:0:8k"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,498 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fwflex.RegisterUnion[awstypes.AgentArtifact](
+		&awstypes.AgentArtifactMemberContainerConfiguration{},
+	)
+	fwflex.RegisterUnion[awstypes.AuthorizerConfiguration](
+		&awstypes.AuthorizerConfigurationMemberCustomJWTAuthorizer{},
+	)
+}
+
+// @FrameworkResource("aws_bedrockagentcore_agent_runtime", name="Agent Runtime")
+// @IdentityAttribute("agent_runtime_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol;bedrockagentcorecontrol;bedrockagentcorecontrol.GetAgentRuntimeOutput")
+// @Testing(importStateIdAttribute="agent_runtime_id")
+// @Testing(requireEnvVar="BEDROCK_AGENTCORE_RUNTIME_CONTAINER_URI")
+// @Testing(hasNoPreExistingResource=true)
+func newAgentRuntimeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &agentRuntimeResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type agentRuntimeResource struct {
+	framework.ResourceWithModel[agentRuntimeResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *agentRuntimeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"agent_runtime_arn": framework.ARNAttributeComputedOnly(),
+			"agent_runtime_id":  framework.IDAttribute(),
+			"agent_runtime_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_runtime_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"environment_variables": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"workload_identity_details": framework.ResourceComputedListOfObjectsAttribute[workloadIdentityDetailsModel](ctx, listplanmodifier.UseStateForUnknown()),
+		},
+		Blocks: map[string]schema.Block{
+			"agent_runtime_artifact": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[agentArtifactModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"container_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"container_uri": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"authorizer_configuration": authorizerConfigurationBlock(ctx),
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"network_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkMode](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"protocol_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[protocolConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"server_protocol": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServerProtocol](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// authorizerConfigurationBlock returns the schema for the authorizer configuration shared by agent runtimes and gateways.
+func authorizerConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[authorizerConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"custom_jwt_authorizer": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[customJWTAuthorizerConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"allowed_audience": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"allowed_clients": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"discovery_url": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *agentRuntimeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentRuntimeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.AgentRuntimeName)
+	var input bedrockagentcorecontrol.CreateAgentRuntimeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateAgentRuntime(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore Agent Runtime (%s)", name), err.Error())
+
+		return
+	}
+
+	agentRuntimeID := aws.ToString(output.AgentRuntimeId)
+	data.AgentRuntimeID = fwflex.StringValueToFramework(ctx, agentRuntimeID)
+
+	runtime, err := waitAgentRuntimeCreated(ctx, conn, agentRuntimeID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("agent_runtime_id"), agentRuntimeID) // Set 'agent_runtime_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Agent Runtime (%s) create", agentRuntimeID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AgentRuntimeARN = fwflex.StringToFramework(ctx, runtime.AgentRuntimeArn)
+	data.AgentRuntimeVersion = fwflex.StringToFramework(ctx, runtime.AgentRuntimeVersion)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, runtime.WorkloadIdentityDetails, &data.WorkloadIdentityDetails)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *agentRuntimeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentRuntimeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID := fwflex.StringValueFromFramework(ctx, data.AgentRuntimeID)
+	output, err := findAgentRuntimeByID(ctx, conn, agentRuntimeID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore Agent Runtime (%s)", agentRuntimeID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentRuntimeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old agentRuntimeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID := fwflex.StringValueFromFramework(ctx, new.AgentRuntimeID)
+	var input bedrockagentcorecontrol.UpdateAgentRuntimeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	_, err := conn.UpdateAgentRuntime(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore Agent Runtime (%s)", agentRuntimeID), err.Error())
+
+		return
+	}
+
+	runtime, err := waitAgentRuntimeUpdated(ctx, conn, agentRuntimeID, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Agent Runtime (%s) update", agentRuntimeID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.AgentRuntimeVersion = fwflex.StringToFramework(ctx, runtime.AgentRuntimeVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *agentRuntimeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentRuntimeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID := fwflex.StringValueFromFramework(ctx, data.AgentRuntimeID)
+	input := bedrockagentcorecontrol.DeleteAgentRuntimeInput{
+		AgentRuntimeId: aws.String(agentRuntimeID),
+	}
+	_, err := conn.DeleteAgentRuntime(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore Agent Runtime (%s)", agentRuntimeID), err.Error())
+
+		return
+	}
+
+	if _, err := waitAgentRuntimeDeleted(ctx, conn, agentRuntimeID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Agent Runtime (%s) delete", agentRuntimeID), err.Error())
+
+		return
+	}
+}
+
+func findAgentRuntimeByID(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string) (*bedrockagentcorecontrol.GetAgentRuntimeOutput, error) {
+	input := bedrockagentcorecontrol.GetAgentRuntimeInput{
+		AgentRuntimeId: aws.String(id),
+	}
+	output, err := conn.GetAgentRuntime(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAgentRuntime(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findAgentRuntimeByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAgentRuntimeCreated(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string, timeout time.Duration) (*bedrockagentcorecontrol.GetAgentRuntimeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusCreating),
+		Target:  enum.Slice(awstypes.AgentStatusReady),
+		Refresh: statusAgentRuntime(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetAgentRuntimeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentRuntimeUpdated(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string, timeout time.Duration) (*bedrockagentcorecontrol.GetAgentRuntimeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusUpdating),
+		Target:  enum.Slice(awstypes.AgentStatusReady),
+		Refresh: statusAgentRuntime(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetAgentRuntimeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentRuntimeDeleted(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string, timeout time.Duration) (*bedrockagentcorecontrol.GetAgentRuntimeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAgentRuntime(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetAgentRuntimeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type agentRuntimeResourceModel struct {
+	framework.WithRegionModel
+	AgentRuntimeARN         types.String                                                  `tfsdk:"agent_runtime_arn"`
+	AgentRuntimeArtifact    fwtypes.ListNestedObjectValueOf[agentArtifactModel]           `tfsdk:"agent_runtime_artifact"`
+	AgentRuntimeID          types.String                                                  `tfsdk:"agent_runtime_id"`
+	AgentRuntimeName        types.String                                                  `tfsdk:"agent_runtime_name"`
+	AgentRuntimeVersion     types.String                                                  `tfsdk:"agent_runtime_version"`
+	AuthorizerConfiguration fwtypes.ListNestedObjectValueOf[authorizerConfigurationModel] `tfsdk:"authorizer_configuration"`
+	Description             types.String                                                  `tfsdk:"description"`
+	EnvironmentVariables    fwtypes.MapOfString                                           `tfsdk:"environment_variables"`
+	NetworkConfiguration    fwtypes.ListNestedObjectValueOf[networkConfigurationModel]    `tfsdk:"network_configuration"`
+	ProtocolConfiguration   fwtypes.ListNestedObjectValueOf[protocolConfigurationModel]   `tfsdk:"protocol_configuration"`
+	RoleARN                 fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+	WorkloadIdentityDetails fwtypes.ListNestedObjectValueOf[workloadIdentityDetailsModel] `tfsdk:"workload_identity_details"`
+}
+
+type agentArtifactModel struct {
+	ContainerConfiguration fwtypes.ListNestedObjectValueOf[containerConfigurationModel] `tfsdk:"container_configuration"`
+}
+
+type containerConfigurationModel struct {
+	ContainerURI types.String `tfsdk:"container_uri"`
+}
+
+type authorizerConfigurationModel struct {
+	CustomJWTAuthorizer fwtypes.ListNestedObjectValueOf[customJWTAuthorizerConfigurationModel] `tfsdk:"custom_jwt_authorizer"`
+}
+
+type customJWTAuthorizerConfigurationModel struct {
+	AllowedAudience fwtypes.SetOfString `tfsdk:"allowed_audience"`
+	AllowedClients  fwtypes.SetOfString `tfsdk:"allowed_clients"`
+	DiscoveryURL    types.String        `tfsdk:"discovery_url"`
+}
+
+type networkConfigurationModel struct {
+	NetworkMode fwtypes.StringEnum[awstypes.NetworkMode] `tfsdk:"network_mode"`
+}
+
+type protocolConfigurationModel struct {
+	ServerProtocol fwtypes.StringEnum[awstypes.ServerProtocol] `tfsdk:"server_protocol"`
+}
+
+type workloadIdentityDetailsModel struct {
+	WorkloadIdentityARN types.String `tfsdk:"workload_identity_arn"`
+}
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagentcore_agent_runtime_endpoint", name="Agent Runtime Endpoint")
+// @IdentityAttribute("agent_runtime_id")
+// @IdentityAttribute("name")
+// @ImportIDHandler("agentRuntimeEndpointImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol;bedrockagentcorecontrol;bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput")
+// @Testing(importStateIdFunc=testAccAgentRuntimeEndpointImportStateIDFunc)
+// @Testing(importStateIdAttribute="agent_runtime_id")
+// @Testing(requireEnvVar="BEDROCK_AGENTCORE_RUNTIME_CONTAINER_URI")
+// @Testing(hasNoPreExistingResource=true)
+func newAgentRuntimeEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &agentRuntimeEndpointResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type agentRuntimeEndpointResource struct {
+	framework.ResourceWithModel[agentRuntimeEndpointResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *agentRuntimeEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"agent_runtime_arn":          framework.ARNAttributeComputedOnly(),
+			"agent_runtime_endpoint_arn": framework.ARNAttributeComputedOnly(),
+			"agent_runtime_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_runtime_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"live_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *agentRuntimeEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentRuntimeEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID, name := fwflex.StringValueFromFramework(ctx, data.AgentRuntimeID), fwflex.StringValueFromFramework(ctx, data.Name)
+	var input bedrockagentcorecontrol.CreateAgentRuntimeEndpointInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	_, err := conn.CreateAgentRuntimeEndpoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore Agent Runtime Endpoint (%s/%s)", agentRuntimeID, name), err.Error())
+
+		return
+	}
+
+	output, err := waitAgentRuntimeEndpointCreated(ctx, conn, agentRuntimeID, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Agent Runtime Endpoint (%s/%s) create", agentRuntimeID, name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *agentRuntimeEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentRuntimeEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID, name := fwflex.StringValueFromFramework(ctx, data.AgentRuntimeID), fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findAgentRuntimeEndpointByTwoPartKey(ctx, conn, agentRuntimeID, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore Agent Runtime Endpoint (%s/%s)", agentRuntimeID, name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentRuntimeEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new agentRuntimeEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID, name := fwflex.StringValueFromFramework(ctx, new.AgentRuntimeID), fwflex.StringValueFromFramework(ctx, new.Name)
+	input := bedrockagentcorecontrol.UpdateAgentRuntimeEndpointInput{
+		AgentRuntimeId:      aws.String(agentRuntimeID),
+		AgentRuntimeVersion: fwflex.StringFromFramework(ctx, new.AgentRuntimeVersion),
+		ClientToken:         aws.String(id.UniqueId()),
+		Description:         fwflex.StringFromFramework(ctx, new.Description),
+		EndpointName:        aws.String(name),
+	}
+
+	_, err := conn.UpdateAgentRuntimeEndpoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore Agent Runtime Endpoint (%s/%s)", agentRuntimeID, name), err.Error())
+
+		return
+	}
+
+	output, err := waitAgentRuntimeEndpointUpdated(ctx, conn, agentRuntimeID, name, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Agent Runtime Endpoint (%s/%s) update", agentRuntimeID, name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(new.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *agentRuntimeEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentRuntimeEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	agentRuntimeID, name := fwflex.StringValueFromFramework(ctx, data.AgentRuntimeID), fwflex.StringValueFromFramework(ctx, data.Name)
+	input := bedrockagentcorecontrol.DeleteAgentRuntimeEndpointInput{
+		AgentRuntimeId: aws.String(agentRuntimeID),
+		ClientToken:    aws.String(id.UniqueId()),
+		EndpointName:   aws.String(name),
+	}
+	_, err := conn.DeleteAgentRuntimeEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore Agent Runtime Endpoint (%s/%s)", agentRuntimeID, name), err.Error())
+
+		return
+	}
+
+	if _, err := waitAgentRuntimeEndpointDeleted(ctx, conn, agentRuntimeID, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Agent Runtime Endpoint (%s/%s) delete", agentRuntimeID, name), err.Error())
+
+		return
+	}
+}
+
+func findAgentRuntimeEndpointByTwoPartKey(ctx context.Context, conn *bedrockagentcorecontrol.Client, agentRuntimeID, name string) (*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput, error) {
+	input := bedrockagentcorecontrol.GetAgentRuntimeEndpointInput{
+		AgentRuntimeId: aws.String(agentRuntimeID),
+		EndpointName:   aws.String(name),
+	}
+	output, err := conn.GetAgentRuntimeEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAgentRuntimeEndpoint(ctx context.Context, conn *bedrockagentcorecontrol.Client, agentRuntimeID, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findAgentRuntimeEndpointByTwoPartKey(ctx, conn, agentRuntimeID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAgentRuntimeEndpointCreated(ctx context.Context, conn *bedrockagentcorecontrol.Client, agentRuntimeID, name string, timeout time.Duration) (*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentEndpointStatusCreating),
+		Target:  enum.Slice(awstypes.AgentEndpointStatusReady),
+		Refresh: statusAgentRuntimeEndpoint(ctx, conn, agentRuntimeID, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentRuntimeEndpointUpdated(ctx context.Context, conn *bedrockagentcorecontrol.Client, agentRuntimeID, name string, timeout time.Duration) (*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentEndpointStatusUpdating),
+		Target:  enum.Slice(awstypes.AgentEndpointStatusReady),
+		Refresh: statusAgentRuntimeEndpoint(ctx, conn, agentRuntimeID, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentRuntimeEndpointDeleted(ctx context.Context, conn *bedrockagentcorecontrol.Client, agentRuntimeID, name string, timeout time.Duration) (*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentEndpointStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAgentRuntimeEndpoint(ctx, conn, agentRuntimeID, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type agentRuntimeEndpointResourceModel struct {
+	framework.WithRegionModel
+	AgentRuntimeARN         types.String   `tfsdk:"agent_runtime_arn"`
+	AgentRuntimeEndpointARN types.String   `tfsdk:"agent_runtime_endpoint_arn"`
+	AgentRuntimeID          types.String   `tfsdk:"agent_runtime_id"`
+	AgentRuntimeVersion     types.String   `tfsdk:"agent_runtime_version"`
+	Description             types.String   `tfsdk:"description"`
+	LiveVersion             types.String   `tfsdk:"live_version" autoflex:"-"`
+	Name                    types.String   `tfsdk:"name"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *agentRuntimeEndpointResourceModel) flatten(ctx context.Context, output *bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The endpoint points at its target version, which becomes the live version once the endpoint is ready.
+	data.AgentRuntimeVersion = fwflex.StringToFramework(ctx, output.TargetVersion)
+	data.LiveVersion = fwflex.StringToFramework(ctx, output.LiveVersion)
+
+	return diags
+}
+
+var _ inttypes.ImportIDParser = agentRuntimeEndpointImportID{}
+
+type agentRuntimeEndpointImportID struct{}
+
+func (agentRuntimeEndpointImportID) Parse(id string) (string, map[string]string, error) {
+	agentRuntimeID, name, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <agent-runtime-id>"+intflex.ResourceIdSeparator+"<name>", id)
+	}
+
+	result := map[string]string{
+		"agent_runtime_id": agentRuntimeID,
+		names.AttrName:     name,
+	}
+
+	return id, result, nil
+}
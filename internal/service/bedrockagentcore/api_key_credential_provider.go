@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagentcore_api_key_credential_provider", name="API Key Credential Provider")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol;bedrockagentcorecontrol;bedrockagentcorecontrol.GetApiKeyCredentialProviderOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(importIgnore="api_key_wo_version")
+// @Testing(hasNoPreExistingResource=true)
+func newAPIKeyCredentialProviderResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &apiKeyCredentialProviderResource{}
+
+	return r, nil
+}
+
+type apiKeyCredentialProviderResource struct {
+	framework.ResourceWithModel[apiKeyCredentialProviderResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *apiKeyCredentialProviderResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_key_secret_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key_wo": schema.StringAttribute{
+				Required:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			"api_key_wo_version": schema.Int64Attribute{
+				Optional: true,
+			},
+			"credential_provider_arn": framework.ARNAttributeComputedOnly(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *apiKeyCredentialProviderResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data apiKeyCredentialProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attribute values are only available from configuration.
+	var apiKey types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKey)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := bedrockagentcorecontrol.CreateApiKeyCredentialProviderInput{
+		ApiKey: fwflex.StringFromFramework(ctx, apiKey),
+		Name:   aws.String(name),
+	}
+
+	output, err := conn.CreateApiKeyCredentialProvider(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore API Key Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CredentialProviderARN = fwflex.StringToFramework(ctx, output.CredentialProviderArn)
+	if v := output.ApiKeySecretArn; v != nil {
+		data.APIKeySecretARN = fwflex.StringToFramework(ctx, v.SecretArn)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *apiKeyCredentialProviderResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data apiKeyCredentialProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findAPIKeyCredentialProviderByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore API Key Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if v := output.ApiKeySecretArn; v != nil {
+		data.APIKeySecretARN = fwflex.StringToFramework(ctx, v.SecretArn)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *apiKeyCredentialProviderResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new apiKeyCredentialProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var apiKey types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKey)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, new.Name)
+	input := bedrockagentcorecontrol.UpdateApiKeyCredentialProviderInput{
+		ApiKey: fwflex.StringFromFramework(ctx, apiKey),
+		Name:   aws.String(name),
+	}
+
+	_, err := conn.UpdateApiKeyCredentialProvider(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore API Key Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *apiKeyCredentialProviderResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data apiKeyCredentialProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := bedrockagentcorecontrol.DeleteApiKeyCredentialProviderInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteApiKeyCredentialProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore API Key Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func findAPIKeyCredentialProviderByName(ctx context.Context, conn *bedrockagentcorecontrol.Client, name string) (*bedrockagentcorecontrol.GetApiKeyCredentialProviderOutput, error) {
+	input := bedrockagentcorecontrol.GetApiKeyCredentialProviderInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.GetApiKeyCredentialProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type apiKeyCredentialProviderResourceModel struct {
+	framework.WithRegionModel
+	APIKeySecretARN       types.String `tfsdk:"api_key_secret_arn" autoflex:"-"`
+	APIKeyWO              types.String `tfsdk:"api_key_wo" autoflex:"-"`
+	APIKeyWOVersion       types.Int64  `tfsdk:"api_key_wo_version" autoflex:"-"`
+	CredentialProviderARN types.String `tfsdk:"credential_provider_arn"`
+	Name                  types.String `tfsdk:"name"`
+}
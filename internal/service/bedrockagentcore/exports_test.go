@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+// Exports for use in tests only.
+var (
+	ResourceAgentRuntime             = newAgentRuntimeResource
+	ResourceAgentRuntimeEndpoint     = newAgentRuntimeEndpointResource
+	ResourceAPIKeyCredentialProvider = newAPIKeyCredentialProviderResource
+	ResourceBrowser                  = newBrowserResource
+	ResourceCodeInterpreter          = newCodeInterpreterResource
+	ResourceGateway                  = newGatewayResource
+	ResourceGatewayTarget            = newGatewayTargetResource
+	ResourceMemory                   = newMemoryResource
+	ResourceMemoryStrategy           = newMemoryStrategyResource
+	ResourceOAuth2CredentialProvider = newOAuth2CredentialProviderResource
+
+	FindAgentRuntimeByID                 = findAgentRuntimeByID
+	FindAgentRuntimeEndpointByTwoPartKey = findAgentRuntimeEndpointByTwoPartKey
+	FindAPIKeyCredentialProviderByName   = findAPIKeyCredentialProviderByName
+	FindBrowserByID                      = findBrowserByID
+	FindCodeInterpreterByID              = findCodeInterpreterByID
+	FindGatewayByID                      = findGatewayByID
+	FindGatewayTargetByTwoPartKey        = findGatewayTargetByTwoPartKey
+	FindMemoryByID                       = findMemoryByID
+	FindMemoryStrategyByTwoPartKey       = findMemoryStrategyByTwoPartKey
+	FindOAuth2CredentialProviderByName   = findOAuth2CredentialProviderByName
+)
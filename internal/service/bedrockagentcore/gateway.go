@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fwflex.RegisterUnion[awstypes.GatewayProtocolConfiguration](
+		&awstypes.GatewayProtocolConfigurationMemberMcp{},
+	)
+}
+
+// @FrameworkResource("aws_bedrockagentcore_gateway", name="Gateway")
+// @IdentityAttribute("gateway_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol;bedrockagentcorecontrol;bedrockagentcorecontrol.GetGatewayOutput")
+// @Testing(importStateIdAttribute="gateway_id")
+// @Testing(hasNoPreExistingResource=true)
+func newGatewayResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &gatewayResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type gatewayResource struct {
+	framework.ResourceWithModel[gatewayResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *gatewayResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorizer_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AuthorizerType](),
+				Required:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"exception_level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExceptionLevel](),
+				Optional:   true,
+			},
+			"gateway_arn": framework.ARNAttributeComputedOnly(),
+			"gateway_id":  framework.IDAttribute(),
+			"gateway_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"protocol_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GatewayProtocolType](),
+				Required:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"workload_identity_details": framework.ResourceComputedListOfObjectsAttribute[workloadIdentityDetailsModel](ctx, listplanmodifier.UseStateForUnknown()),
+		},
+		Blocks: map[string]schema.Block{
+			"authorizer_configuration": authorizerConfigurationBlock(ctx),
+			"protocol_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[gatewayProtocolConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"mcp": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mcpGatewayConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"instructions": schema.StringAttribute{
+										Optional: true,
+									},
+									"search_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.SearchType](),
+										Optional:   true,
+									},
+									"supported_versions": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *gatewayResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data gatewayResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input bedrockagentcorecontrol.CreateGatewayInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateGateway(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore Gateway (%s)", name), err.Error())
+
+		return
+	}
+
+	gatewayID := aws.ToString(output.GatewayId)
+	data.GatewayID = fwflex.StringValueToFramework(ctx, gatewayID)
+
+	gateway, err := waitGatewayCreated(ctx, conn, gatewayID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("gateway_id"), gatewayID) // Set 'gateway_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Gateway (%s) create", gatewayID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.GatewayARN = fwflex.StringToFramework(ctx, gateway.GatewayArn)
+	data.GatewayURL = fwflex.StringToFramework(ctx, gateway.GatewayUrl)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, gateway.WorkloadIdentityDetails, &data.WorkloadIdentityDetails)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *gatewayResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data gatewayResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID := fwflex.StringValueFromFramework(ctx, data.GatewayID)
+	output, err := findGatewayByID(ctx, conn, gatewayID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore Gateway (%s)", gatewayID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *gatewayResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new gatewayResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID := fwflex.StringValueFromFramework(ctx, new.GatewayID)
+	var input bedrockagentcorecontrol.UpdateGatewayInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.GatewayIdentifier = aws.String(gatewayID)
+
+	_, err := conn.UpdateGateway(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore Gateway (%s)", gatewayID), err.Error())
+
+		return
+	}
+
+	if _, err := waitGatewayUpdated(ctx, conn, gatewayID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Gateway (%s) update", gatewayID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *gatewayResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data gatewayResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID := fwflex.StringValueFromFramework(ctx, data.GatewayID)
+	input := bedrockagentcorecontrol.DeleteGatewayInput{
+		GatewayIdentifier: aws.String(gatewayID),
+	}
+	_, err := conn.DeleteGateway(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore Gateway (%s)", gatewayID), err.Error())
+
+		return
+	}
+
+	if _, err := waitGatewayDeleted(ctx, conn, gatewayID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Gateway (%s) delete", gatewayID), err.Error())
+
+		return
+	}
+}
+
+func findGatewayByID(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string) (*bedrockagentcorecontrol.GetGatewayOutput, error) {
+	input := bedrockagentcorecontrol.GetGatewayInput{
+		GatewayIdentifier: aws.String(id),
+	}
+	output, err := conn.GetGateway(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGateway(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findGatewayByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitGatewayCreated(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string, timeout time.Duration) (*bedrockagentcorecontrol.GetGatewayOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStatusCreating),
+		Target:  enum.Slice(awstypes.GatewayStatusReady),
+		Refresh: statusGateway(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetGatewayOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.StatusReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayUpdated(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string, timeout time.Duration) (*bedrockagentcorecontrol.GetGatewayOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStatusUpdating),
+		Target:  enum.Slice(awstypes.GatewayStatusReady),
+		Refresh: statusGateway(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetGatewayOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.StatusReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayDeleted(ctx context.Context, conn *bedrockagentcorecontrol.Client, id string, timeout time.Duration) (*bedrockagentcorecontrol.GetGatewayOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStatusDeleting),
+		Target:  []string{},
+		Refresh: statusGateway(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetGatewayOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.StatusReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type gatewayResourceModel struct {
+	framework.WithRegionModel
+	AuthorizerConfiguration fwtypes.ListNestedObjectValueOf[authorizerConfigurationModel]      `tfsdk:"authorizer_configuration"`
+	AuthorizerType          fwtypes.StringEnum[awstypes.AuthorizerType]                        `tfsdk:"authorizer_type"`
+	Description             types.String                                                       `tfsdk:"description"`
+	ExceptionLevel          fwtypes.StringEnum[awstypes.ExceptionLevel]                        `tfsdk:"exception_level"`
+	GatewayARN              types.String                                                       `tfsdk:"gateway_arn"`
+	GatewayID               types.String                                                       `tfsdk:"gateway_id"`
+	GatewayURL              types.String                                                       `tfsdk:"gateway_url"`
+	KMSKeyARN               fwtypes.ARN                                                        `tfsdk:"kms_key_arn"`
+	Name                    types.String                                                       `tfsdk:"name"`
+	ProtocolConfiguration   fwtypes.ListNestedObjectValueOf[gatewayProtocolConfigurationModel] `tfsdk:"protocol_configuration"`
+	ProtocolType            fwtypes.StringEnum[awstypes.GatewayProtocolType]                   `tfsdk:"protocol_type"`
+	RoleARN                 fwtypes.ARN                                                        `tfsdk:"role_arn"`
+	Timeouts                timeouts.Value                                                     `tfsdk:"timeouts"`
+	WorkloadIdentityDetails fwtypes.ListNestedObjectValueOf[workloadIdentityDetailsModel]      `tfsdk:"workload_identity_details"`
+}
+
+type gatewayProtocolConfigurationModel struct {
+	MCP fwtypes.ListNestedObjectValueOf[mcpGatewayConfigurationModel] `tfsdk:"mcp"`
+}
+
+type mcpGatewayConfigurationModel struct {
+	Instructions      types.String                            `tfsdk:"instructions"`
+	SearchType        fwtypes.StringEnum[awstypes.SearchType] `tfsdk:"search_type"`
+	SupportedVersions fwtypes.SetOfString                     `tfsdk:"supported_versions"`
+}
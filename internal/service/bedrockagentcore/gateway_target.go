@@ -0,0 +1,748 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fwflex.RegisterUnion[awstypes.ApiSchemaConfiguration](
+		&awstypes.ApiSchemaConfigurationMemberInlinePayload{},
+		&awstypes.ApiSchemaConfigurationMemberS3{},
+	)
+	fwflex.RegisterUnion[awstypes.CredentialProvider](
+		&awstypes.CredentialProviderMemberApiKeyCredentialProvider{},
+		&awstypes.CredentialProviderMemberOauthCredentialProvider{},
+	)
+	fwflex.RegisterUnion[awstypes.McpTargetConfiguration](
+		&awstypes.McpTargetConfigurationMemberLambda{},
+		&awstypes.McpTargetConfigurationMemberOpenApiSchema{},
+		&awstypes.McpTargetConfigurationMemberSmithyModel{},
+	)
+	fwflex.RegisterUnion[awstypes.TargetConfiguration](
+		&awstypes.TargetConfigurationMemberMcp{},
+	)
+	fwflex.RegisterUnion[awstypes.ToolSchema](
+		&awstypes.ToolSchemaMemberInlinePayload{},
+		&awstypes.ToolSchemaMemberS3{},
+	)
+}
+
+// @FrameworkResource("aws_bedrockagentcore_gateway_target", name="Gateway Target")
+// @IdentityAttribute("gateway_identifier")
+// @IdentityAttribute("target_id")
+// @ImportIDHandler("gatewayTargetImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol;bedrockagentcorecontrol;bedrockagentcorecontrol.GetGatewayTargetOutput")
+// @Testing(importStateIdFunc=testAccGatewayTargetImportStateIDFunc)
+// @Testing(importStateIdAttribute="target_id")
+// @Testing(hasNoPreExistingResource=true)
+func newGatewayTargetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &gatewayTargetResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type gatewayTargetResource struct {
+	framework.ResourceWithModel[gatewayTargetResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *gatewayTargetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3ConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"bucket_owner_account_id": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.AWSAccountID(),
+					},
+				},
+				names.AttrURI: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+	apiSchemaConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"inline_payload": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"s3": s3ConfigurationBlock,
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"gateway_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"target_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credential_provider_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[credentialProviderConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"credential_provider_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CredentialProviderType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"credential_provider": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[credentialProviderModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"api_key_credential_provider": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[apiKeyCredentialProviderModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"credential_location": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ApiKeyCredentialLocation](),
+													Optional:   true,
+												},
+												"credential_parameter_name": schema.StringAttribute{
+													Optional: true,
+												},
+												"credential_prefix": schema.StringAttribute{
+													Optional: true,
+												},
+												"provider_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+									"oauth_credential_provider": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[oauthCredentialProviderModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"custom_parameters": schema.MapAttribute{
+													CustomType:  fwtypes.MapOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"provider_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												"scopes": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"target_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[targetConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"mcp": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mcpTargetConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"lambda": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[mcpLambdaTargetConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"lambda_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"tool_schema": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[toolSchemaModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"inline_payload": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[toolDefinitionModel](ctx),
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrDescription: schema.StringAttribute{
+																			Required: true,
+																		},
+																		"input_schema": schema.StringAttribute{
+																			CustomType: jsontypes.NormalizedType{},
+																			Required:   true,
+																		},
+																		names.AttrName: schema.StringAttribute{
+																			Required: true,
+																		},
+																		"output_schema": schema.StringAttribute{
+																			CustomType: jsontypes.NormalizedType{},
+																			Optional:   true,
+																		},
+																	},
+																},
+															},
+															"s3": s3ConfigurationBlock,
+														},
+													},
+												},
+											},
+										},
+									},
+									"open_api_schema": apiSchemaConfigurationBlock,
+									"smithy_model":    apiSchemaConfigurationBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *gatewayTargetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data gatewayTargetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID, name := fwflex.StringValueFromFramework(ctx, data.GatewayIdentifier), fwflex.StringValueFromFramework(ctx, data.Name)
+	var input bedrockagentcorecontrol.CreateGatewayTargetInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateGatewayTarget(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore Gateway (%s) Target (%s)", gatewayID, name), err.Error())
+
+		return
+	}
+
+	targetID := aws.ToString(output.TargetId)
+	data.TargetID = fwflex.StringValueToFramework(ctx, targetID)
+
+	if _, err := waitGatewayTargetCreated(ctx, conn, gatewayID, targetID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root("gateway_identifier"), gatewayID)
+		response.State.SetAttribute(ctx, path.Root("target_id"), targetID) // Set 'target_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Gateway (%s) Target (%s) create", gatewayID, targetID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *gatewayTargetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data gatewayTargetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID, targetID := fwflex.StringValueFromFramework(ctx, data.GatewayIdentifier), fwflex.StringValueFromFramework(ctx, data.TargetID)
+	output, err := findGatewayTargetByTwoPartKey(ctx, conn, gatewayID, targetID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore Gateway (%s) Target (%s)", gatewayID, targetID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *gatewayTargetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new gatewayTargetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID, targetID := fwflex.StringValueFromFramework(ctx, new.GatewayIdentifier), fwflex.StringValueFromFramework(ctx, new.TargetID)
+	var input bedrockagentcorecontrol.UpdateGatewayTargetInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateGatewayTarget(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore Gateway (%s) Target (%s)", gatewayID, targetID), err.Error())
+
+		return
+	}
+
+	if _, err := waitGatewayTargetUpdated(ctx, conn, gatewayID, targetID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Gateway (%s) Target (%s) update", gatewayID, targetID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *gatewayTargetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data gatewayTargetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	gatewayID, targetID := fwflex.StringValueFromFramework(ctx, data.GatewayIdentifier), fwflex.StringValueFromFramework(ctx, data.TargetID)
+	input := bedrockagentcorecontrol.DeleteGatewayTargetInput{
+		GatewayIdentifier: aws.String(gatewayID),
+		TargetId:          aws.String(targetID),
+	}
+	_, err := conn.DeleteGatewayTarget(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore Gateway (%s) Target (%s)", gatewayID, targetID), err.Error())
+
+		return
+	}
+
+	if _, err := waitGatewayTargetDeleted(ctx, conn, gatewayID, targetID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Gateway (%s) Target (%s) delete", gatewayID, targetID), err.Error())
+
+		return
+	}
+}
+
+func findGatewayTargetByTwoPartKey(ctx context.Context, conn *bedrockagentcorecontrol.Client, gatewayID, targetID string) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error) {
+	input := bedrockagentcorecontrol.GetGatewayTargetInput{
+		GatewayIdentifier: aws.String(gatewayID),
+		TargetId:          aws.String(targetID),
+	}
+	output, err := conn.GetGatewayTarget(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGatewayTarget(ctx context.Context, conn *bedrockagentcorecontrol.Client, gatewayID, targetID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findGatewayTargetByTwoPartKey(ctx, conn, gatewayID, targetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitGatewayTargetCreated(ctx context.Context, conn *bedrockagentcorecontrol.Client, gatewayID, targetID string, timeout time.Duration) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TargetStatusCreating),
+		Target:  enum.Slice(awstypes.TargetStatusReady),
+		Refresh: statusGatewayTarget(ctx, conn, gatewayID, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetGatewayTargetOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.StatusReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayTargetUpdated(ctx context.Context, conn *bedrockagentcorecontrol.Client, gatewayID, targetID string, timeout time.Duration) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TargetStatusUpdating),
+		Target:  enum.Slice(awstypes.TargetStatusReady),
+		Refresh: statusGatewayTarget(ctx, conn, gatewayID, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetGatewayTargetOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.StatusReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayTargetDeleted(ctx context.Context, conn *bedrockagentcorecontrol.Client, gatewayID, targetID string, timeout time.Duration) (*bedrockagentcorecontrol.GetGatewayTargetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TargetStatusDeleting),
+		Target:  []string{},
+		Refresh: statusGatewayTarget(ctx, conn, gatewayID, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagentcorecontrol.GetGatewayTargetOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.StatusReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type gatewayTargetResourceModel struct {
+	framework.WithRegionModel
+	CredentialProviderConfigurations fwtypes.ListNestedObjectValueOf[credentialProviderConfigurationModel] `tfsdk:"credential_provider_configuration"`
+	Description                      types.String                                                          `tfsdk:"description"`
+	GatewayIdentifier                types.String                                                          `tfsdk:"gateway_identifier"`
+	Name                             types.String                                                          `tfsdk:"name"`
+	TargetConfiguration              fwtypes.ListNestedObjectValueOf[targetConfigurationModel]             `tfsdk:"target_configuration"`
+	TargetID                         types.String                                                          `tfsdk:"target_id"`
+	Timeouts                         timeouts.Value                                                        `tfsdk:"timeouts"`
+}
+
+type credentialProviderConfigurationModel struct {
+	CredentialProvider     fwtypes.ListNestedObjectValueOf[credentialProviderModel] `tfsdk:"credential_provider"`
+	CredentialProviderType fwtypes.StringEnum[awstypes.CredentialProviderType]      `tfsdk:"credential_provider_type"`
+}
+
+type credentialProviderModel struct {
+	APIKeyCredentialProvider fwtypes.ListNestedObjectValueOf[apiKeyCredentialProviderModel] `tfsdk:"api_key_credential_provider"`
+	OAuthCredentialProvider  fwtypes.ListNestedObjectValueOf[oauthCredentialProviderModel]  `tfsdk:"oauth_credential_provider"`
+}
+
+type apiKeyCredentialProviderModel struct {
+	CredentialLocation      fwtypes.StringEnum[awstypes.ApiKeyCredentialLocation] `tfsdk:"credential_location"`
+	CredentialParameterName types.String                                          `tfsdk:"credential_parameter_name"`
+	CredentialPrefix        types.String                                          `tfsdk:"credential_prefix"`
+	ProviderARN             fwtypes.ARN                                           `tfsdk:"provider_arn"`
+}
+
+type oauthCredentialProviderModel struct {
+	CustomParameters fwtypes.MapOfString `tfsdk:"custom_parameters"`
+	ProviderARN      fwtypes.ARN         `tfsdk:"provider_arn"`
+	Scopes           fwtypes.SetOfString `tfsdk:"scopes"`
+}
+
+type targetConfigurationModel struct {
+	MCP fwtypes.ListNestedObjectValueOf[mcpTargetConfigurationModel] `tfsdk:"mcp"`
+}
+
+type mcpTargetConfigurationModel struct {
+	Lambda        fwtypes.ListNestedObjectValueOf[mcpLambdaTargetConfigurationModel] `tfsdk:"lambda"`
+	OpenAPISchema fwtypes.ListNestedObjectValueOf[apiSchemaConfigurationModel]       `tfsdk:"open_api_schema"`
+	SmithyModel   fwtypes.ListNestedObjectValueOf[apiSchemaConfigurationModel]       `tfsdk:"smithy_model"`
+}
+
+type mcpLambdaTargetConfigurationModel struct {
+	LambdaARN  fwtypes.ARN                                      `tfsdk:"lambda_arn"`
+	ToolSchema fwtypes.ListNestedObjectValueOf[toolSchemaModel] `tfsdk:"tool_schema"`
+}
+
+type toolSchemaModel struct {
+	InlinePayload fwtypes.ListNestedObjectValueOf[toolDefinitionModel]  `tfsdk:"inline_payload"`
+	S3            fwtypes.ListNestedObjectValueOf[s3ConfigurationModel] `tfsdk:"s3"`
+}
+
+type apiSchemaConfigurationModel struct {
+	InlinePayload types.String                                          `tfsdk:"inline_payload"`
+	S3            fwtypes.ListNestedObjectValueOf[s3ConfigurationModel] `tfsdk:"s3"`
+}
+
+type s3ConfigurationModel struct {
+	BucketOwnerAccountID types.String `tfsdk:"bucket_owner_account_id"`
+	URI                  types.String `tfsdk:"uri"`
+}
+
+// Tool input and output schemas are recursive JSON Schema-like structures, so they are modeled as JSON strings.
+type toolDefinitionModel struct {
+	Description  types.String         `tfsdk:"description"`
+	InputSchema  jsontypes.Normalized `tfsdk:"input_schema"`
+	Name         types.String         `tfsdk:"name"`
+	OutputSchema jsontypes.Normalized `tfsdk:"output_schema"`
+}
+
+var (
+	_ fwflex.Expander  = toolDefinitionModel{}
+	_ fwflex.Flattener = &toolDefinitionModel{}
+)
+
+func (m toolDefinitionModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	r := awstypes.ToolDefinition{
+		Description: fwflex.StringFromFramework(ctx, m.Description),
+		Name:        fwflex.StringFromFramework(ctx, m.Name),
+	}
+
+	for _, v := range []struct {
+		from jsontypes.Normalized
+		to   **awstypes.SchemaDefinition
+	}{
+		{m.InputSchema, &r.InputSchema},
+		{m.OutputSchema, &r.OutputSchema},
+	} {
+		if v.from.IsNull() || v.from.IsUnknown() {
+			continue
+		}
+
+		var schemaDefinition awstypes.SchemaDefinition
+		if err := json.Unmarshal([]byte(v.from.ValueString()), &schemaDefinition); err != nil {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Decoding JSON",
+				err.Error(),
+			))
+
+			return nil, diags
+		}
+
+		*v.to = &schemaDefinition
+	}
+
+	return &r, diags
+}
+
+func (m *toolDefinitionModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var toolDefinition awstypes.ToolDefinition
+	switch v := v.(type) {
+	case awstypes.ToolDefinition:
+		toolDefinition = v
+	case *awstypes.ToolDefinition:
+		toolDefinition = *v
+	default:
+		return diags
+	}
+
+	m.Description = fwflex.StringToFramework(ctx, toolDefinition.Description)
+	m.Name = fwflex.StringToFramework(ctx, toolDefinition.Name)
+
+	for _, v := range []struct {
+		from *awstypes.SchemaDefinition
+		to   *jsontypes.Normalized
+	}{
+		{toolDefinition.InputSchema, &m.InputSchema},
+		{toolDefinition.OutputSchema, &m.OutputSchema},
+	} {
+		if v.from == nil {
+			*v.to = jsontypes.NewNormalizedNull()
+			continue
+		}
+
+		b, err := json.Marshal(schemaDefinitionToJSON(v.from))
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Encoding JSON",
+				err.Error(),
+			))
+
+			return diags
+		}
+
+		*v.to = jsontypes.NewNormalizedValue(string(b))
+	}
+
+	return diags
+}
+
+// schemaDefinitionToJSON returns a JSON-encodable representation of a tool schema definition using JSON Schema keywords.
+func schemaDefinitionToJSON(apiObject *awstypes.SchemaDefinition) map[string]any {
+	tfMap := map[string]any{
+		"type": apiObject.Type,
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Items; v != nil {
+		tfMap["items"] = schemaDefinitionToJSON(v)
+	}
+
+	if v := apiObject.Properties; len(v) > 0 {
+		properties := make(map[string]any, len(v))
+		for k, v := range v {
+			properties[k] = schemaDefinitionToJSON(&v)
+		}
+		tfMap["properties"] = properties
+	}
+
+	if v := apiObject.Required; len(v) > 0 {
+		tfMap["required"] = v
+	}
+
+	return tfMap
+}
+
+var _ inttypes.ImportIDParser = gatewayTargetImportID{}
+
+type gatewayTargetImportID struct{}
+
+func (gatewayTargetImportID) Parse(id string) (string, map[string]string, error) {
+	gatewayID, targetID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <gateway-identifier>"+intflex.ResourceIdSeparator+"<target-id>", id)
+	}
+
+	result := map[string]string{
+		"gateway_identifier": gatewayID,
+		"target_id":          targetID,
+	}
+
+	return id, result, nil
+}
@@ -0,0 +1,728 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrockagentcore_memory_strategy", name="Memory Strategy")
+// @IdentityAttribute("memory_id")
+// @IdentityAttribute("memory_strategy_id")
+// @ImportIDHandler("memoryStrategyImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types;awstypes;awstypes.MemoryStrategy")
+// @Testing(importStateIdFunc=testAccMemoryStrategyImportStateIDFunc)
+// @Testing(importStateIdAttribute="memory_strategy_id")
+// @Testing(hasNoPreExistingResource=true)
+func newMemoryStrategyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &memoryStrategyResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type memoryStrategyResource struct {
+	framework.ResourceWithModel[memoryStrategyResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *memoryStrategyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	overrideBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[memoryStrategyOverrideModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"append_to_prompt": schema.StringAttribute{
+						Required: true,
+					},
+					"model_id": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"memory_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"memory_strategy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespaces": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MemoryStrategyType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[memoryStrategyConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OverrideType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"consolidation": overrideBlock(),
+						"extraction":    overrideBlock(),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *memoryStrategyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data memoryStrategyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	memoryID, name := fwflex.StringValueFromFramework(ctx, data.MemoryID), fwflex.StringValueFromFramework(ctx, data.Name)
+	memoryStrategyInput, diags := data.expandMemoryStrategyInput(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := bedrockagentcorecontrol.UpdateMemoryInput{
+		ClientToken: aws.String(id.UniqueId()),
+		MemoryId:    aws.String(memoryID),
+		MemoryStrategies: &awstypes.ModifyMemoryStrategies{
+			AddMemoryStrategies: []awstypes.MemoryStrategyInput{memoryStrategyInput},
+		},
+	}
+
+	output, err := conn.UpdateMemory(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore Memory (%s) Strategy (%s)", memoryID, name), err.Error())
+
+		return
+	}
+
+	strategy, err := tfresource.AssertSingleValueResult(tfslices.Filter(output.Memory.Strategies, func(v awstypes.MemoryStrategy) bool {
+		return aws.ToString(v.Name) == name
+	}))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore Memory (%s) Strategy (%s)", memoryID, name), err.Error())
+
+		return
+	}
+
+	strategyID := aws.ToString(strategy.StrategyId)
+	data.MemoryStrategyID = fwflex.StringValueToFramework(ctx, strategyID)
+
+	strategy, err = waitMemoryStrategyCreated(ctx, conn, memoryID, strategyID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("memory_id"), memoryID)
+		response.State.SetAttribute(ctx, path.Root("memory_strategy_id"), strategyID) // Set 'memory_strategy_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Memory (%s) Strategy (%s) create", memoryID, strategyID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Namespaces = fwflex.FlattenFrameworkStringValueSetOfString(ctx, strategy.Namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *memoryStrategyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data memoryStrategyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	memoryID, strategyID := fwflex.StringValueFromFramework(ctx, data.MemoryID), fwflex.StringValueFromFramework(ctx, data.MemoryStrategyID)
+	output, err := findMemoryStrategyByTwoPartKey(ctx, conn, memoryID, strategyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore Memory (%s) Strategy (%s)", memoryID, strategyID), err.Error())
+
+		return
+	}
+
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.MemoryStrategyID = fwflex.StringToFramework(ctx, output.StrategyId)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Namespaces = fwflex.FlattenFrameworkStringValueSetOfString(ctx, output.Namespaces)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+	response.Diagnostics.Append(data.flattenConfiguration(ctx, output.Configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *memoryStrategyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new memoryStrategyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	memoryID, strategyID := fwflex.StringValueFromFramework(ctx, new.MemoryID), fwflex.StringValueFromFramework(ctx, new.MemoryStrategyID)
+	modifyInput := awstypes.ModifyMemoryStrategyInput{
+		Description:      fwflex.StringFromFramework(ctx, new.Description),
+		MemoryStrategyId: aws.String(strategyID),
+		Namespaces:       fwflex.ExpandFrameworkStringValueSet(ctx, new.Namespaces),
+	}
+	configuration, diags := new.expandModifyStrategyConfiguration(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	modifyInput.Configuration = configuration
+
+	input := bedrockagentcorecontrol.UpdateMemoryInput{
+		ClientToken: aws.String(id.UniqueId()),
+		MemoryId:    aws.String(memoryID),
+		MemoryStrategies: &awstypes.ModifyMemoryStrategies{
+			ModifyMemoryStrategies: []awstypes.ModifyMemoryStrategyInput{modifyInput},
+		},
+	}
+
+	_, err := conn.UpdateMemory(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore Memory (%s) Strategy (%s)", memoryID, strategyID), err.Error())
+
+		return
+	}
+
+	if _, err := waitMemoryStrategyUpdated(ctx, conn, memoryID, strategyID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Memory (%s) Strategy (%s) update", memoryID, strategyID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *memoryStrategyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data memoryStrategyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	memoryID, strategyID := fwflex.StringValueFromFramework(ctx, data.MemoryID), fwflex.StringValueFromFramework(ctx, data.MemoryStrategyID)
+	input := bedrockagentcorecontrol.UpdateMemoryInput{
+		ClientToken: aws.String(id.UniqueId()),
+		MemoryId:    aws.String(memoryID),
+		MemoryStrategies: &awstypes.ModifyMemoryStrategies{
+			DeleteMemoryStrategies: []awstypes.DeleteMemoryStrategyInput{
+				{
+					MemoryStrategyId: aws.String(strategyID),
+				},
+			},
+		},
+	}
+	_, err := conn.UpdateMemory(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore Memory (%s) Strategy (%s)", memoryID, strategyID), err.Error())
+
+		return
+	}
+
+	if _, err := waitMemoryStrategyDeleted(ctx, conn, memoryID, strategyID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock AgentCore Memory (%s) Strategy (%s) delete", memoryID, strategyID), err.Error())
+
+		return
+	}
+}
+
+func findMemoryStrategyByTwoPartKey(ctx context.Context, conn *bedrockagentcorecontrol.Client, memoryID, strategyID string) (*awstypes.MemoryStrategy, error) {
+	memory, err := findMemoryByID(ctx, conn, memoryID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := tfresource.AssertSingleValueResult(tfslices.Filter(memory.Strategies, func(v awstypes.MemoryStrategy) bool {
+		return aws.ToString(v.StrategyId) == strategyID
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusMemoryStrategy(ctx context.Context, conn *bedrockagentcorecontrol.Client, memoryID, strategyID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findMemoryStrategyByTwoPartKey(ctx, conn, memoryID, strategyID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitMemoryStrategyCreated(ctx context.Context, conn *bedrockagentcorecontrol.Client, memoryID, strategyID string, timeout time.Duration) (*awstypes.MemoryStrategy, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MemoryStrategyStatusCreating),
+		Target:  enum.Slice(awstypes.MemoryStrategyStatusActive),
+		Refresh: statusMemoryStrategy(ctx, conn, memoryID, strategyID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.MemoryStrategy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemoryStrategyUpdated(ctx context.Context, conn *bedrockagentcorecontrol.Client, memoryID, strategyID string, timeout time.Duration) (*awstypes.MemoryStrategy, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MemoryStrategyStatusCreating),
+		Target:  enum.Slice(awstypes.MemoryStrategyStatusActive),
+		Refresh: statusMemoryStrategy(ctx, conn, memoryID, strategyID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.MemoryStrategy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemoryStrategyDeleted(ctx context.Context, conn *bedrockagentcorecontrol.Client, memoryID, strategyID string, timeout time.Duration) (*awstypes.MemoryStrategy, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MemoryStrategyStatusDeleting, awstypes.MemoryStrategyStatusActive),
+		Target:  []string{},
+		Refresh: statusMemoryStrategy(ctx, conn, memoryID, strategyID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.MemoryStrategy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type memoryStrategyResourceModel struct {
+	framework.WithRegionModel
+	Configuration    fwtypes.ListNestedObjectValueOf[memoryStrategyConfigurationModel] `tfsdk:"configuration"`
+	Description      types.String                                                      `tfsdk:"description"`
+	MemoryID         types.String                                                      `tfsdk:"memory_id"`
+	MemoryStrategyID types.String                                                      `tfsdk:"memory_strategy_id"`
+	Name             types.String                                                      `tfsdk:"name"`
+	Namespaces       fwtypes.SetOfString                                               `tfsdk:"namespaces"`
+	Timeouts         timeouts.Value                                                    `tfsdk:"timeouts"`
+	Type             fwtypes.StringEnum[awstypes.MemoryStrategyType]                   `tfsdk:"type"`
+}
+
+type memoryStrategyConfigurationModel struct {
+	Consolidation fwtypes.ListNestedObjectValueOf[memoryStrategyOverrideModel] `tfsdk:"consolidation"`
+	Extraction    fwtypes.ListNestedObjectValueOf[memoryStrategyOverrideModel] `tfsdk:"extraction"`
+	Type          fwtypes.StringEnum[awstypes.OverrideType]                    `tfsdk:"type"`
+}
+
+type memoryStrategyOverrideModel struct {
+	AppendToPrompt types.String `tfsdk:"append_to_prompt"`
+	ModelID        types.String `tfsdk:"model_id"`
+}
+
+// The memory strategy APIs use a different (deeply nested) union for each of input, modify and output,
+// so the resource's configuration is expanded and flattened by hand.
+
+func (data *memoryStrategyResourceModel) expandMemoryStrategyInput(ctx context.Context) (awstypes.MemoryStrategyInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	description, name, namespaces := fwflex.StringFromFramework(ctx, data.Description), fwflex.StringFromFramework(ctx, data.Name), fwflex.ExpandFrameworkStringValueSet(ctx, data.Namespaces)
+
+	switch strategyType := data.Type.ValueEnum(); strategyType {
+	case awstypes.MemoryStrategyTypeSemantic:
+		return &awstypes.MemoryStrategyInputMemberSemanticMemoryStrategy{
+			Value: awstypes.SemanticMemoryStrategyInput{
+				Description: description,
+				Name:        name,
+				Namespaces:  namespaces,
+			},
+		}, diags
+	case awstypes.MemoryStrategyTypeSummarization:
+		return &awstypes.MemoryStrategyInputMemberSummaryMemoryStrategy{
+			Value: awstypes.SummaryMemoryStrategyInput{
+				Description: description,
+				Name:        name,
+				Namespaces:  namespaces,
+			},
+		}, diags
+	case awstypes.MemoryStrategyTypeUserPreference:
+		return &awstypes.MemoryStrategyInputMemberUserPreferenceMemoryStrategy{
+			Value: awstypes.UserPreferenceMemoryStrategyInput{
+				Description: description,
+				Name:        name,
+				Namespaces:  namespaces,
+			},
+		}, diags
+	case awstypes.MemoryStrategyTypeCustom:
+		configurationData, d := data.Configuration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if configurationData == nil {
+			diags.AddAttributeError(path.Root(names.AttrConfiguration), "Missing Attribute Configuration", fmt.Sprintf("configuration must be specified when type is %s", strategyType))
+
+			return nil, diags
+		}
+
+		consolidation, extraction, d := configurationData.expandOverrides(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var configuration awstypes.CustomConfigurationInput
+		switch configurationData.Type.ValueEnum() {
+		case awstypes.OverrideTypeSemanticOverride:
+			var v awstypes.SemanticOverrideConfigurationInput
+			if consolidation != nil {
+				v.Consolidation = &awstypes.SemanticOverrideConsolidationConfigurationInput{
+					AppendToPrompt: consolidation.AppendToPrompt,
+					ModelId:        consolidation.ModelId,
+				}
+			}
+			if extraction != nil {
+				v.Extraction = &awstypes.SemanticOverrideExtractionConfigurationInput{
+					AppendToPrompt: extraction.AppendToPrompt,
+					ModelId:        extraction.ModelId,
+				}
+			}
+			configuration = &awstypes.CustomConfigurationInputMemberSemanticOverride{Value: v}
+		case awstypes.OverrideTypeSummaryOverride:
+			var v awstypes.SummaryOverrideConfigurationInput
+			if consolidation != nil {
+				v.Consolidation = &awstypes.SummaryOverrideConsolidationConfigurationInput{
+					AppendToPrompt: consolidation.AppendToPrompt,
+					ModelId:        consolidation.ModelId,
+				}
+			}
+			configuration = &awstypes.CustomConfigurationInputMemberSummaryOverride{Value: v}
+		case awstypes.OverrideTypeUserPreferenceOverride:
+			var v awstypes.UserPreferenceOverrideConfigurationInput
+			if consolidation != nil {
+				v.Consolidation = &awstypes.UserPreferenceOverrideConsolidationConfigurationInput{
+					AppendToPrompt: consolidation.AppendToPrompt,
+					ModelId:        consolidation.ModelId,
+				}
+			}
+			if extraction != nil {
+				v.Extraction = &awstypes.UserPreferenceOverrideExtractionConfigurationInput{
+					AppendToPrompt: extraction.AppendToPrompt,
+					ModelId:        extraction.ModelId,
+				}
+			}
+			configuration = &awstypes.CustomConfigurationInputMemberUserPreferenceOverride{Value: v}
+		}
+
+		return &awstypes.MemoryStrategyInputMemberCustomMemoryStrategy{
+			Value: awstypes.CustomMemoryStrategyInput{
+				Configuration: configuration,
+				Description:   description,
+				Name:          name,
+				Namespaces:    namespaces,
+			},
+		}, diags
+	}
+
+	diags.AddAttributeError(path.Root(names.AttrType), "Invalid Attribute Value", fmt.Sprintf("unsupported memory strategy type: %s", data.Type.ValueString()))
+
+	return nil, diags
+}
+
+func (data *memoryStrategyResourceModel) expandModifyStrategyConfiguration(ctx context.Context) (*awstypes.ModifyStrategyConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configurationData, d := data.Configuration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configurationData == nil {
+		return nil, diags
+	}
+
+	consolidation, extraction, d := configurationData.expandOverrides(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObject awstypes.ModifyStrategyConfiguration
+
+	switch configurationData.Type.ValueEnum() {
+	case awstypes.OverrideTypeSemanticOverride:
+		if consolidation != nil {
+			apiObject.Consolidation = &awstypes.ModifyConsolidationConfigurationMemberCustomConsolidationConfiguration{
+				Value: &awstypes.CustomConsolidationConfigurationInputMemberSemanticConsolidationOverride{
+					Value: awstypes.SemanticOverrideConsolidationConfigurationInput{
+						AppendToPrompt: consolidation.AppendToPrompt,
+						ModelId:        consolidation.ModelId,
+					},
+				},
+			}
+		}
+		if extraction != nil {
+			apiObject.Extraction = &awstypes.ModifyExtractionConfigurationMemberCustomExtractionConfiguration{
+				Value: &awstypes.CustomExtractionConfigurationInputMemberSemanticExtractionOverride{
+					Value: awstypes.SemanticOverrideExtractionConfigurationInput{
+						AppendToPrompt: extraction.AppendToPrompt,
+						ModelId:        extraction.ModelId,
+					},
+				},
+			}
+		}
+	case awstypes.OverrideTypeSummaryOverride:
+		if consolidation != nil {
+			apiObject.Consolidation = &awstypes.ModifyConsolidationConfigurationMemberCustomConsolidationConfiguration{
+				Value: &awstypes.CustomConsolidationConfigurationInputMemberSummaryConsolidationOverride{
+					Value: awstypes.SummaryOverrideConsolidationConfigurationInput{
+						AppendToPrompt: consolidation.AppendToPrompt,
+						ModelId:        consolidation.ModelId,
+					},
+				},
+			}
+		}
+	case awstypes.OverrideTypeUserPreferenceOverride:
+		if consolidation != nil {
+			apiObject.Consolidation = &awstypes.ModifyConsolidationConfigurationMemberCustomConsolidationConfiguration{
+				Value: &awstypes.CustomConsolidationConfigurationInputMemberUserPreferenceConsolidationOverride{
+					Value: awstypes.UserPreferenceOverrideConsolidationConfigurationInput{
+						AppendToPrompt: consolidation.AppendToPrompt,
+						ModelId:        consolidation.ModelId,
+					},
+				},
+			}
+		}
+		if extraction != nil {
+			apiObject.Extraction = &awstypes.ModifyExtractionConfigurationMemberCustomExtractionConfiguration{
+				Value: &awstypes.CustomExtractionConfigurationInputMemberUserPreferenceExtractionOverride{
+					Value: awstypes.UserPreferenceOverrideExtractionConfigurationInput{
+						AppendToPrompt: extraction.AppendToPrompt,
+						ModelId:        extraction.ModelId,
+					},
+				},
+			}
+		}
+	}
+
+	return &apiObject, diags
+}
+
+// memoryStrategyOverride is the common shape of all consolidation and extraction overrides.
+type memoryStrategyOverride struct {
+	AppendToPrompt *string
+	ModelId        *string
+}
+
+func (data *memoryStrategyConfigurationModel) expandOverrides(ctx context.Context) (*memoryStrategyOverride, *memoryStrategyOverride, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expand := func(v fwtypes.ListNestedObjectValueOf[memoryStrategyOverrideModel]) *memoryStrategyOverride {
+		m, d := v.ToPtr(ctx)
+		diags.Append(d...)
+		if m == nil {
+			return nil
+		}
+
+		return &memoryStrategyOverride{
+			AppendToPrompt: fwflex.StringFromFramework(ctx, m.AppendToPrompt),
+			ModelId:        fwflex.StringFromFramework(ctx, m.ModelID),
+		}
+	}
+
+	return expand(data.Consolidation), expand(data.Extraction), diags
+}
+
+func (data *memoryStrategyResourceModel) flattenConfiguration(ctx context.Context, apiObject *awstypes.StrategyConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || apiObject.Type == "" {
+		data.Configuration = fwtypes.NewListNestedObjectValueOfNull[memoryStrategyConfigurationModel](ctx)
+
+		return diags
+	}
+
+	flatten := func(v *memoryStrategyOverride) fwtypes.ListNestedObjectValueOf[memoryStrategyOverrideModel] {
+		if v == nil {
+			return fwtypes.NewListNestedObjectValueOfNull[memoryStrategyOverrideModel](ctx)
+		}
+
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &memoryStrategyOverrideModel{
+			AppendToPrompt: fwflex.StringToFramework(ctx, v.AppendToPrompt),
+			ModelID:        fwflex.StringToFramework(ctx, v.ModelId),
+		})
+	}
+
+	var consolidation, extraction *memoryStrategyOverride
+
+	if v, ok := apiObject.Consolidation.(*awstypes.ConsolidationConfigurationMemberCustomConsolidationConfiguration); ok {
+		switch v := v.Value.(type) {
+		case *awstypes.CustomConsolidationConfigurationMemberSemanticConsolidationOverride:
+			consolidation = &memoryStrategyOverride{AppendToPrompt: v.Value.AppendToPrompt, ModelId: v.Value.ModelId}
+		case *awstypes.CustomConsolidationConfigurationMemberSummaryConsolidationOverride:
+			consolidation = &memoryStrategyOverride{AppendToPrompt: v.Value.AppendToPrompt, ModelId: v.Value.ModelId}
+		case *awstypes.CustomConsolidationConfigurationMemberUserPreferenceConsolidationOverride:
+			consolidation = &memoryStrategyOverride{AppendToPrompt: v.Value.AppendToPrompt, ModelId: v.Value.ModelId}
+		}
+	}
+
+	if v, ok := apiObject.Extraction.(*awstypes.ExtractionConfigurationMemberCustomExtractionConfiguration); ok {
+		switch v := v.Value.(type) {
+		case *awstypes.CustomExtractionConfigurationMemberSemanticExtractionOverride:
+			extraction = &memoryStrategyOverride{AppendToPrompt: v.Value.AppendToPrompt, ModelId: v.Value.ModelId}
+		case *awstypes.CustomExtractionConfigurationMemberUserPreferenceExtractionOverride:
+			extraction = &memoryStrategyOverride{AppendToPrompt: v.Value.AppendToPrompt, ModelId: v.Value.ModelId}
+		}
+	}
+
+	data.Configuration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &memoryStrategyConfigurationModel{
+		Consolidation: flatten(consolidation),
+		Extraction:    flatten(extraction),
+		Type:          fwtypes.StringEnumValue(apiObject.Type),
+	})
+
+	return diags
+}
+
+var _ inttypes.ImportIDParser = memoryStrategyImportID{}
+
+type memoryStrategyImportID struct{}
+
+func (memoryStrategyImportID) Parse(id string) (string, map[string]string, error) {
+	memoryID, strategyID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <memory-id>"+intflex.ResourceIdSeparator+"<memory-strategy-id>", id)
+	}
+
+	result := map[string]string{
+		"memory_id":          memoryID,
+		"memory_strategy_id": strategyID,
+	}
+
+	return id, result, nil
+}
@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fwflex.RegisterUnion[awstypes.Oauth2Discovery](
+		&awstypes.Oauth2DiscoveryMemberAuthorizationServerMetadata{},
+		&awstypes.Oauth2DiscoveryMemberDiscoveryUrl{},
+	)
+}
+
+// @FrameworkResource("aws_bedrockagentcore_oauth2_credential_provider", name="OAuth2 Credential Provider")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol;bedrockagentcorecontrol;bedrockagentcorecontrol.GetOauth2CredentialProviderOutput")
+// @Testing(importStateIdAttribute="name")
+// The client ID is not returned by the API.
+// @Testing(importIgnore="client_secret_wo_version;oauth2_provider_config")
+// @Testing(hasNoPreExistingResource=true)
+func newOAuth2CredentialProviderResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &oauth2CredentialProviderResource{}
+
+	return r, nil
+}
+
+type oauth2CredentialProviderResource struct {
+	framework.ResourceWithModel[oauth2CredentialProviderResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *oauth2CredentialProviderResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_secret_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret_wo": schema.StringAttribute{
+				Required:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			"client_secret_wo_version": schema.Int64Attribute{
+				Optional: true,
+			},
+			"credential_provider_arn": framework.ARNAttributeComputedOnly(),
+			"credential_provider_vendor": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CredentialProviderVendorType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oauth2_provider_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[oauth2ProviderConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrClientID: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"oauth_discovery": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oauth2DiscoveryModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"discovery_url": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"authorization_server_metadata": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[oauth2AuthorizationServerMetadataModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"authorization_endpoint": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrIssuer: schema.StringAttribute{
+													Required: true,
+												},
+												"response_types": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"token_endpoint": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *oauth2CredentialProviderResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data oauth2CredentialProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attribute values are only available from configuration.
+	var clientSecret types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &clientSecret)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	providerConfig, diags := data.expandOAuth2ProviderConfigInput(ctx, clientSecret)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := bedrockagentcorecontrol.CreateOauth2CredentialProviderInput{
+		CredentialProviderVendor:  data.CredentialProviderVendor.ValueEnum(),
+		Name:                      aws.String(name),
+		Oauth2ProviderConfigInput: providerConfig,
+	}
+
+	output, err := conn.CreateOauth2CredentialProvider(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock AgentCore OAuth2 Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CredentialProviderARN = fwflex.StringToFramework(ctx, output.CredentialProviderArn)
+	if v := output.ClientSecretArn; v != nil {
+		data.ClientSecretARN = fwflex.StringToFramework(ctx, v.SecretArn)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *oauth2CredentialProviderResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data oauth2CredentialProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findOAuth2CredentialProviderByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock AgentCore OAuth2 Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	// The provider configuration (client ID and discovery) is not flattened as the API does not return the client ID.
+	data.CredentialProviderARN = fwflex.StringToFramework(ctx, output.CredentialProviderArn)
+	data.CredentialProviderVendor = fwtypes.StringEnumValue(output.CredentialProviderVendor)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	if v := output.ClientSecretArn; v != nil {
+		data.ClientSecretARN = fwflex.StringToFramework(ctx, v.SecretArn)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *oauth2CredentialProviderResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new oauth2CredentialProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var clientSecret types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("client_secret_wo"), &clientSecret)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, new.Name)
+	providerConfig, diags := new.expandOAuth2ProviderConfigInput(ctx, clientSecret)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := bedrockagentcorecontrol.UpdateOauth2CredentialProviderInput{
+		CredentialProviderVendor:  new.CredentialProviderVendor.ValueEnum(),
+		Name:                      aws.String(name),
+		Oauth2ProviderConfigInput: providerConfig,
+	}
+
+	_, err := conn.UpdateOauth2CredentialProvider(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock AgentCore OAuth2 Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *oauth2CredentialProviderResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data oauth2CredentialProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentCoreClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := bedrockagentcorecontrol.DeleteOauth2CredentialProviderInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteOauth2CredentialProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock AgentCore OAuth2 Credential Provider (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func findOAuth2CredentialProviderByName(ctx context.Context, conn *bedrockagentcorecontrol.Client, name string) (*bedrockagentcorecontrol.GetOauth2CredentialProviderOutput, error) {
+	input := bedrockagentcorecontrol.GetOauth2CredentialProviderInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.GetOauth2CredentialProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type oauth2CredentialProviderResourceModel struct {
+	framework.WithRegionModel
+	ClientSecretARN          types.String                                               `tfsdk:"client_secret_arn"`
+	ClientSecretWO           types.String                                               `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion    types.Int64                                                `tfsdk:"client_secret_wo_version"`
+	CredentialProviderARN    types.String                                               `tfsdk:"credential_provider_arn"`
+	CredentialProviderVendor fwtypes.StringEnum[awstypes.CredentialProviderVendorType]  `tfsdk:"credential_provider_vendor"`
+	Name                     types.String                                               `tfsdk:"name"`
+	OAuth2ProviderConfig     fwtypes.ListNestedObjectValueOf[oauth2ProviderConfigModel] `tfsdk:"oauth2_provider_config"`
+}
+
+type oauth2ProviderConfigModel struct {
+	ClientID       types.String                                          `tfsdk:"client_id"`
+	OAuthDiscovery fwtypes.ListNestedObjectValueOf[oauth2DiscoveryModel] `tfsdk:"oauth_discovery"`
+}
+
+type oauth2DiscoveryModel struct {
+	AuthorizationServerMetadata fwtypes.ListNestedObjectValueOf[oauth2AuthorizationServerMetadataModel] `tfsdk:"authorization_server_metadata"`
+	DiscoveryURL                types.String                                                            `tfsdk:"discovery_url"`
+}
+
+type oauth2AuthorizationServerMetadataModel struct {
+	AuthorizationEndpoint types.String        `tfsdk:"authorization_endpoint"`
+	Issuer                types.String        `tfsdk:"issuer"`
+	ResponseTypes         fwtypes.SetOfString `tfsdk:"response_types"`
+	TokenEndpoint         types.String        `tfsdk:"token_endpoint"`
+}
+
+// expandOAuth2ProviderConfigInput returns the vendor-specific provider configuration, including the write-only client secret.
+func (data *oauth2CredentialProviderResourceModel) expandOAuth2ProviderConfigInput(ctx context.Context, clientSecret types.String) (awstypes.Oauth2ProviderConfigInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configData, d := data.OAuth2ProviderConfig.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	clientID, secret := fwflex.StringFromFramework(ctx, configData.ClientID), fwflex.StringFromFramework(ctx, clientSecret)
+
+	switch vendor := data.CredentialProviderVendor.ValueEnum(); vendor {
+	case awstypes.CredentialProviderVendorTypeCustomOauth2:
+		var v awstypes.CustomOauth2ProviderConfigInput
+		diags.Append(fwflex.Expand(ctx, configData, &v)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		v.ClientSecret = secret
+
+		return &awstypes.Oauth2ProviderConfigInputMemberCustomOauth2ProviderConfig{Value: v}, diags
+	case awstypes.CredentialProviderVendorTypeGithubOauth2:
+		return &awstypes.Oauth2ProviderConfigInputMemberGithubOauth2ProviderConfig{
+			Value: awstypes.GithubOauth2ProviderConfigInput{ClientId: clientID, ClientSecret: secret},
+		}, diags
+	case awstypes.CredentialProviderVendorTypeGoogleOauth2:
+		return &awstypes.Oauth2ProviderConfigInputMemberGoogleOauth2ProviderConfig{
+			Value: awstypes.GoogleOauth2ProviderConfigInput{ClientId: clientID, ClientSecret: secret},
+		}, diags
+	case awstypes.CredentialProviderVendorTypeMicrosoftOauth2:
+		return &awstypes.Oauth2ProviderConfigInputMemberMicrosoftOauth2ProviderConfig{
+			Value: awstypes.MicrosoftOauth2ProviderConfigInput{ClientId: clientID, ClientSecret: secret},
+		}, diags
+	case awstypes.CredentialProviderVendorTypeSalesforceOauth2:
+		return &awstypes.Oauth2ProviderConfigInputMemberSalesforceOauth2ProviderConfig{
+			Value: awstypes.SalesforceOauth2ProviderConfigInput{ClientId: clientID, ClientSecret: secret},
+		}, diags
+	case awstypes.CredentialProviderVendorTypeSlackOauth2:
+		return &awstypes.Oauth2ProviderConfigInputMemberSlackOauth2ProviderConfig{
+			Value: awstypes.SlackOauth2ProviderConfigInput{ClientId: clientID, ClientSecret: secret},
+		}, diags
+	default:
+		diags.AddAttributeError(path.Root("credential_provider_vendor"), "Invalid Attribute Value", fmt.Sprintf("unsupported credential provider vendor: %s", vendor))
+
+		return nil, diags
+	}
+}
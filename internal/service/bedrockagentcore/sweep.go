@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagentcore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_bedrockagentcore_agent_runtime", sweepAgentRuntimes,
+		"aws_bedrockagentcore_agent_runtime_endpoint",
+	)
+	awsv2.Register("aws_bedrockagentcore_agent_runtime_endpoint", sweepAgentRuntimeEndpoints)
+	awsv2.Register("aws_bedrockagentcore_api_key_credential_provider", sweepAPIKeyCredentialProviders)
+	awsv2.Register("aws_bedrockagentcore_browser", sweepBrowsers)
+	awsv2.Register("aws_bedrockagentcore_code_interpreter", sweepCodeInterpreters)
+	awsv2.Register("aws_bedrockagentcore_gateway", sweepGateways,
+		"aws_bedrockagentcore_gateway_target",
+	)
+	awsv2.Register("aws_bedrockagentcore_gateway_target", sweepGatewayTargets)
+	awsv2.Register("aws_bedrockagentcore_memory", sweepMemories)
+	awsv2.Register("aws_bedrockagentcore_oauth2_credential_provider", sweepOAuth2CredentialProviders)
+}
+
+func sweepAgentRuntimes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListAgentRuntimesPaginator(conn, &bedrockagentcorecontrol.ListAgentRuntimesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AgentRuntimes {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAgentRuntimeResource, client,
+				framework.NewAttribute("agent_runtime_id", aws.ToString(v.AgentRuntimeId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepAgentRuntimeEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	agentRuntimes := bedrockagentcorecontrol.NewListAgentRuntimesPaginator(conn, &bedrockagentcorecontrol.ListAgentRuntimesInput{})
+	for agentRuntimes.HasMorePages() {
+		page, err := agentRuntimes.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, agentRuntime := range page.AgentRuntimes {
+			agentRuntimeID := aws.ToString(agentRuntime.AgentRuntimeId)
+			endpoints := bedrockagentcorecontrol.NewListAgentRuntimeEndpointsPaginator(conn, &bedrockagentcorecontrol.ListAgentRuntimeEndpointsInput{
+				AgentRuntimeId: aws.String(agentRuntimeID),
+			})
+			for endpoints.HasMorePages() {
+				page, err := endpoints.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, endpoint := range page.RuntimeEndpoints {
+					name := aws.ToString(endpoint.Name)
+
+					// The default endpoint is managed by the service.
+					if name == "DEFAULT" {
+						continue
+					}
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newAgentRuntimeEndpointResource, client,
+						framework.NewAttribute("agent_runtime_id", agentRuntimeID),
+						framework.NewAttribute(names.AttrName, name),
+					))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepAPIKeyCredentialProviders(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListApiKeyCredentialProvidersPaginator(conn, &bedrockagentcorecontrol.ListApiKeyCredentialProvidersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CredentialProviders {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAPIKeyCredentialProviderResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepBrowsers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListBrowsersPaginator(conn, &bedrockagentcorecontrol.ListBrowsersInput{
+		Type: awstypes.ResourceTypeCustom,
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.BrowserSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newBrowserResource, client,
+				framework.NewAttribute("browser_id", aws.ToString(v.BrowserId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepCodeInterpreters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListCodeInterpretersPaginator(conn, &bedrockagentcorecontrol.ListCodeInterpretersInput{
+		Type: awstypes.ResourceTypeCustom,
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CodeInterpreterSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newCodeInterpreterResource, client,
+				framework.NewAttribute("code_interpreter_id", aws.ToString(v.CodeInterpreterId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListGatewaysPaginator(conn, &bedrockagentcorecontrol.ListGatewaysInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGatewayResource, client,
+				framework.NewAttribute("gateway_id", aws.ToString(v.GatewayId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepGatewayTargets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	gateways := bedrockagentcorecontrol.NewListGatewaysPaginator(conn, &bedrockagentcorecontrol.ListGatewaysInput{})
+	for gateways.HasMorePages() {
+		page, err := gateways.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, gateway := range page.Items {
+			gatewayID := aws.ToString(gateway.GatewayId)
+			targets := bedrockagentcorecontrol.NewListGatewayTargetsPaginator(conn, &bedrockagentcorecontrol.ListGatewayTargetsInput{
+				GatewayIdentifier: aws.String(gatewayID),
+			})
+			for targets.HasMorePages() {
+				page, err := targets.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, target := range page.Items {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newGatewayTargetResource, client,
+						framework.NewAttribute("gateway_identifier", gatewayID),
+						framework.NewAttribute("target_id", aws.ToString(target.TargetId)),
+					))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepMemories(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListMemoriesPaginator(conn, &bedrockagentcorecontrol.ListMemoriesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Memories {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMemoryResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepOAuth2CredentialProviders(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentCoreClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagentcorecontrol.NewListOauth2CredentialProvidersPaginator(conn, &bedrockagentcorecontrol.ListOauth2CredentialProvidersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CredentialProviders {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newOAuth2CredentialProviderResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrockagentcore_api_key_credential_provider" "test" {
+  name               = var.rName
+  api_key_wo         = "test-api-key"
+  api_key_wo_version = 1
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrockagentcore_code_interpreter" "test" {
+  name = replace(var.rName, "-", "_")
+
+  network_configuration {
+    network_mode = "PUBLIC"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
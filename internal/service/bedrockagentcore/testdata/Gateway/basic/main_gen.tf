@@ -0,0 +1,49 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrockagentcore_gateway" "test" {
+  name            = var.rName
+  role_arn        = aws_iam_role.test.arn
+  authorizer_type = "CUSTOM_JWT"
+  protocol_type   = "MCP"
+
+  authorizer_configuration {
+    custom_jwt_authorizer {
+      discovery_url    = "https://cognito-idp.${data.aws_region.current.region}.amazonaws.com/${aws_cognito_user_pool.test.id}/.well-known/openid-configuration"
+      allowed_audience = [aws_cognito_user_pool_client.test.id]
+    }
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "aws_cognito_user_pool" "test" {
+  name = var.rName
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = var.rName
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+data "aws_iam_policy_document" "assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["bedrock-agentcore.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = var.rName
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
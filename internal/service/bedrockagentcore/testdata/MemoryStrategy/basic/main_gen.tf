@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrockagentcore_memory_strategy" "test" {
+  name       = replace(var.rName, "-", "_")
+  memory_id  = aws_bedrockagentcore_memory.test.id
+  type       = "SEMANTIC"
+  namespaces = ["default"]
+}
+
+resource "aws_bedrockagentcore_memory" "test" {
+  name                  = replace(var.rName, "-", "_")
+  event_expiry_duration = 7
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrockagentcore_memory_strategy" "test" {
+  region = var.region
+
+  name       = replace(var.rName, "-", "_")
+  memory_id  = aws_bedrockagentcore_memory.test.id
+  type       = "SEMANTIC"
+  namespaces = ["default"]
+}
+
+resource "aws_bedrockagentcore_memory" "test" {
+  region = var.region
+
+  name                  = replace(var.rName, "-", "_")
+  event_expiry_duration = 7
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_bedrockagentcore_oauth2_credential_provider" "test" {
+  region = var.region
+
+  name                       = var.rName
+  credential_provider_vendor = "GithubOauth2"
+  client_secret_wo           = "test-client-secret"
+  client_secret_wo_version   = 1
+
+  oauth2_provider_config {
+    client_id = "test-client-id"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
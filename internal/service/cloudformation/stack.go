@@ -18,14 +18,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -383,7 +385,7 @@ func findStackByName(ctx context.Context, conn *cloudformation.Client, name stri
 	}
 
 	if status := output.StackStatus; status == awstypes.StackStatusDeleteComplete {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastRequest: input,
 			Message:     string(status),
 		}
@@ -410,7 +412,7 @@ func findStacks(ctx context.Context, conn *cloudformation.Client, input *cloudfo
 		page, err := pages.NextPage(ctx)
 
 		if tfawserr.ErrMessageContains(err, errCodeValidationError, "does not exist") {
-			return nil, &retry.NotFoundError{
+			return nil, &sdkretry.NotFoundError{
 				LastError:   err,
 				LastRequest: input,
 			}
@@ -426,7 +428,7 @@ func findStacks(ctx context.Context, conn *cloudformation.Client, input *cloudfo
 	return output, nil
 }
 
-func statusStack(ctx context.Context, conn *cloudformation.Client, name string) retry.StateRefreshFunc {
+func statusStack(ctx context.Context, conn *cloudformation.Client, name string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		// Don't call FindStackByName as it maps useful status codes to NotFoundError.
 		output, err := findStack(ctx, conn, &cloudformation.DescribeStacksInput{
@@ -449,7 +451,7 @@ func waitStackCreated(ctx context.Context, conn *cloudformation.Client, name, re
 	const (
 		minTimeout = 1 * time.Second
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := sdkretry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StackStatusCreateInProgress, awstypes.StackStatusDeleteInProgress, awstypes.StackStatusRollbackInProgress),
 		Target:     enum.Slice(awstypes.StackStatusCreateComplete, awstypes.StackStatusCreateFailed, awstypes.StackStatusDeleteComplete, awstypes.StackStatusDeleteFailed, awstypes.StackStatusRollbackComplete, awstypes.StackStatusRollbackFailed),
 		Timeout:    timeout,
@@ -458,7 +460,7 @@ func waitStackCreated(ctx context.Context, conn *cloudformation.Client, name, re
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(&stateConf, stackWaitStatus).WaitForStateContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +509,7 @@ func waitStackUpdated(ctx context.Context, conn *cloudformation.Client, name, re
 	const (
 		minTimeout = 5 * time.Second
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := sdkretry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.StackStatusUpdateCompleteCleanupInProgress, awstypes.StackStatusUpdateInProgress, awstypes.StackStatusUpdateRollbackInProgress, awstypes.StackStatusUpdateRollbackCompleteCleanupInProgress),
 		Target:     enum.Slice(awstypes.StackStatusCreateComplete, awstypes.StackStatusUpdateComplete, awstypes.StackStatusUpdateRollbackComplete, awstypes.StackStatusUpdateRollbackFailed),
 		Timeout:    timeout,
@@ -516,7 +518,7 @@ func waitStackUpdated(ctx context.Context, conn *cloudformation.Client, name, re
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(&stateConf, stackWaitStatus).WaitForStateContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -548,7 +550,7 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 	const (
 		minTimeout = 5 * time.Second
 	)
-	stateConf := retry.StateChangeConf{
+	stateConf := sdkretry.StateChangeConf{
 		Pending:        enum.Slice(awstypes.StackStatusDeleteInProgress, awstypes.StackStatusRollbackInProgress),
 		Target:         enum.Slice(awstypes.StackStatusDeleteComplete, awstypes.StackStatusDeleteFailed),
 		Timeout:        timeout,
@@ -558,7 +560,7 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(&stateConf, stackWaitStatus).WaitForStateContext(ctx)
 	switch {
 	case tfresource.NotFound(err):
 		return nil, nil
@@ -589,6 +591,17 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 	return output, err
 }
 
+// stackWaitStatus returns the status reported while waiting for a stack.
+func stackWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*awstypes.Stack); ok {
+		status.Reason = aws.ToString(output.StackStatusReason)
+	}
+
+	return status
+}
+
 func findStackEventsForOperation(ctx context.Context, conn *cloudformation.Client, name, requestToken string, filter tfslices.Predicate[*awstypes.StackEvent]) ([]awstypes.StackEvent, error) {
 	input := &cloudformation.DescribeStackEventsInput{
 		StackName: aws.String(name),
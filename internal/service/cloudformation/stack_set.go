@@ -18,14 +18,16 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -479,14 +481,14 @@ func findStackSetByName(ctx context.Context, conn *cloudformation.Client, name,
 	output, err := conn.DescribeStackSet(ctx, input)
 
 	if errs.IsA[*awstypes.StackSetNotFoundException](err) {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
 	}
 
 	if callAs == string(awstypes.CallAsDelegatedAdmin) && tfawserr.ErrMessageContains(err, errCodeValidationError, "Failed to check account is Delegated Administrator") {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
@@ -503,7 +505,7 @@ func findStackSetByName(ctx context.Context, conn *cloudformation.Client, name,
 	return output.StackSet, nil
 }
 
-func statusStackSet(ctx context.Context, conn *cloudformation.Client, name, callAs string) retry.StateRefreshFunc {
+func statusStackSet(ctx context.Context, conn *cloudformation.Client, name, callAs string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findStackSetByName(ctx, conn, name, callAs)
 
@@ -520,7 +522,7 @@ func statusStackSet(ctx context.Context, conn *cloudformation.Client, name, call
 }
 
 func waitStackSetCreated(ctx context.Context, conn *cloudformation.Client, name, callAs string, timeout time.Duration) (*awstypes.StackSet, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := sdkretry.StateChangeConf{
 		Pending: []string{},
 		Target:  enum.Slice(awstypes.StackSetStatusActive),
 		Timeout: timeout,
@@ -549,7 +551,7 @@ func findStackSetOperationByThreePartKey(ctx context.Context, conn *cloudformati
 	output, err := conn.DescribeStackSetOperation(ctx, input)
 
 	if errs.IsA[*awstypes.OperationNotFoundException](err) {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
@@ -595,7 +597,7 @@ func findStackSetOperationResultsByThreePartKey(ctx context.Context, conn *cloud
 	return findStackSetOperationResults(ctx, conn, input)
 }
 
-func statusStackSetOperation(ctx context.Context, conn *cloudformation.Client, stackSetName, operationID, callAs string) retry.StateRefreshFunc {
+func statusStackSetOperation(ctx context.Context, conn *cloudformation.Client, stackSetName, operationID, callAs string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findStackSetOperationByThreePartKey(ctx, conn, stackSetName, operationID, callAs)
 
@@ -615,7 +617,7 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 	const (
 		stackSetOperationDelay = 10 * time.Second
 	)
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: enum.Slice(awstypes.StackSetOperationStatusRunning, awstypes.StackSetOperationStatusQueued),
 		Target:  enum.Slice(awstypes.StackSetOperationStatusSucceeded),
 		Refresh: statusStackSetOperation(ctx, conn, stackSetName, operationID, callAs),
@@ -623,7 +625,7 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 		Delay:   stackSetOperationDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, stackSetOperationWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.StackSetOperation); ok {
 		if output.Status == awstypes.StackSetOperationStatusFailed {
@@ -638,6 +640,17 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 	return nil, err
 }
 
+// stackSetOperationWaitStatus returns the status reported while waiting for a stack set operation.
+func stackSetOperationWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*awstypes.StackSetOperation); ok {
+		status.Reason = aws.ToString(output.StatusReason)
+	}
+
+	return status
+}
+
 func stackSetOperationError(apiObjects []awstypes.StackSetOperationResultSummary) error {
 	var errs []error
 
@@ -11,6 +11,7 @@ var (
 	ResourceHoursOfOperation                  = resourceHoursOfOperation
 	ResourceInstance                          = resourceInstance
 	ResourceInstanceStorageConfig             = resourceInstanceStorageConfig
+	ResourceIntegrationAssociation            = newIntegrationAssociationResource
 	ResourceLambdaFunctionAssociation         = resourceLambdaFunctionAssociation
 	ResourcePhoneNumber                       = resourcePhoneNumber
 	ResourcePhoneNumberContactFlowAssociation = newPhoneNumberContactFlowAssociationResource
@@ -29,6 +30,7 @@ var (
 	FindHoursOfOperationByTwoPartKey                    = findHoursOfOperationByTwoPartKey
 	FindInstanceByID                                    = findInstanceByID
 	FindInstanceStorageConfigByThreePartKey             = findInstanceStorageConfigByThreePartKey
+	FindIntegrationAssociationByTwoPartKey              = findIntegrationAssociationByTwoPartKey
 	FindLambdaFunctionAssociationByTwoPartKey           = findLambdaFunctionAssociationByTwoPartKey
 	FindPhoneNumberByID                                 = findPhoneNumberByID
 	FindPhoneNumberContactFlowAssociationByThreePartKey = findPhoneNumberContactFlowAssociationByThreePartKey
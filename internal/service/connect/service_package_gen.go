@@ -24,6 +24,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newIntegrationAssociationResource,
+			TypeName: "aws_connect_integration_association",
+			Name:     "Integration Association",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newPhoneNumberContactFlowAssociationResource,
 			TypeName: "aws_connect_phone_number_contact_flow_association",
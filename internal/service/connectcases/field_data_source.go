@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_connectcases_field", name="Field")
+// @Tags(identifierAttribute="arn")
+func newFieldDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &fieldDataSource{}, nil
+}
+
+type fieldDataSource struct {
+	framework.DataSourceWithModel[fieldDataSourceModel]
+}
+
+func (d *fieldDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+			},
+			"field_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldNamespace](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldType](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *fieldDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data fieldDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ConnectCasesClient(ctx)
+
+	domainID, name := fwflex.StringValueFromFramework(ctx, data.DomainID), fwflex.StringValueFromFramework(ctx, data.Name)
+	input := connectcases.ListFieldsInput{
+		DomainId: aws.String(domainID),
+	}
+	summary, err := findField(ctx, conn, &input, func(v *awstypes.FieldSummary) bool {
+		return aws.ToString(v.Name) == name
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s)", name), tfresource.SingularDataSourceFindError("Connect Cases Field", err).Error())
+
+		return
+	}
+
+	fieldID := aws.ToString(summary.FieldId)
+	output, err := findFieldByTwoPartKey(ctx, conn, domainID, fieldID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s)", fieldID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findField(ctx context.Context, conn *connectcases.Client, input *connectcases.ListFieldsInput, filter tfslices.Predicate[*awstypes.FieldSummary]) (*awstypes.FieldSummary, error) {
+	output, err := findFields(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFields(ctx context.Context, conn *connectcases.Client, input *connectcases.ListFieldsInput, filter tfslices.Predicate[*awstypes.FieldSummary]) ([]awstypes.FieldSummary, error) {
+	var output []awstypes.FieldSummary
+
+	pages := connectcases.NewListFieldsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Fields {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type fieldDataSourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String                                `tfsdk:"arn" autoflex:"name=FieldArn"`
+	Description types.String                                `tfsdk:"description"`
+	DomainID    types.String                                `tfsdk:"domain_id"`
+	FieldID     types.String                                `tfsdk:"field_id"`
+	Name        types.String                                `tfsdk:"name"`
+	Namespace   fwtypes.StringEnum[awstypes.FieldNamespace] `tfsdk:"namespace"`
+	Tags        tftags.Map                                  `tfsdk:"tags"`
+	Type        fwtypes.StringEnum[awstypes.FieldType]      `tfsdk:"type"`
+}
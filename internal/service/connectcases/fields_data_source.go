@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_connectcases_fields", name="Fields")
+func newFieldsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &fieldsDataSource{}, nil
+}
+
+type fieldsDataSource struct {
+	framework.DataSourceWithModel[fieldsDataSourceModel]
+}
+
+func (d *fieldsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_id": schema.StringAttribute{
+				Required: true,
+			},
+			"fields": framework.DataSourceComputedListOfObjectAttribute[fieldSummaryModel](ctx),
+			names.AttrNamespace: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldNamespace](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *fieldsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data fieldsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ConnectCasesClient(ctx)
+
+	domainID := fwflex.StringValueFromFramework(ctx, data.DomainID)
+	input := connectcases.ListFieldsInput{
+		DomainId: aws.String(domainID),
+	}
+	filter := tfslices.PredicateTrue[*awstypes.FieldSummary]()
+	if namespace := data.Namespace.ValueEnum(); namespace != "" {
+		filter = func(v *awstypes.FieldSummary) bool {
+			return v.Namespace == namespace
+		}
+	}
+	output, err := findFields(ctx, conn, &input, filter)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s) Fields", domainID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Fields)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type fieldsDataSourceModel struct {
+	framework.WithRegionModel
+	DomainID  types.String                                       `tfsdk:"domain_id"`
+	Fields    fwtypes.ListNestedObjectValueOf[fieldSummaryModel] `tfsdk:"fields"`
+	Namespace fwtypes.StringEnum[awstypes.FieldNamespace]        `tfsdk:"namespace"`
+}
+
+type fieldSummaryModel struct {
+	ARN       types.String                                `tfsdk:"arn" autoflex:"name=FieldArn"`
+	FieldID   types.String                                `tfsdk:"field_id"`
+	Name      types.String                                `tfsdk:"name"`
+	Namespace fwtypes.StringEnum[awstypes.FieldNamespace] `tfsdk:"namespace"`
+	Type      fwtypes.StringEnum[awstypes.FieldType]      `tfsdk:"type"`
+}
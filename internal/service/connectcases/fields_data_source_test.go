@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccConnectCasesFieldsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_connectcases_fields.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "fields.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fields.0.arn", "aws_connectcases_field.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fields.0.field_id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "fields.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "fields.0.namespace", "Custom"),
+					resource.TestCheckResourceAttr(dataSourceName, "fields.0.type", "Text"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFieldsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_basic(rName), `
+data "aws_connectcases_fields" "test" {
+  domain_id = aws_connectcases_field.test.domain_id
+  namespace = "Custom"
+}
+`)
+}
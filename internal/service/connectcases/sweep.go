@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_connectcases_domain", sweepDomains)
+}
+
+func sweepDomains(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ConnectCasesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	var input connectcases.ListDomainsInput
+	pages := connectcases.NewListDomainsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Domains {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDomainResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.DomainId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recipeVersionLatestPublished = "LATEST_PUBLISHED"
+	recipeVersionLatestWorking   = "LATEST_WORKING"
+
+	recipeVersionsMaxBatchSize = 50
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(importIgnore="publish")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newRecipeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &recipeResource{}, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"published_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"steps": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expressions": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input databrew.CreateRecipeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	if data.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrName), name) // Set 'name' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	publishedVersion, err := findRecipePublishedVersion(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published version", name), err.Error())
+
+		return
+	}
+
+	data.PublishedVersion = fwflex.StringToFramework(ctx, publishedVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	publishedVersion, err := findRecipePublishedVersion(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published version", name), err.Error())
+
+		return
+	}
+
+	data.PublishedVersion = fwflex.StringToFramework(ctx, publishedVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, new.Name)
+
+	if new.hasRecipeChanges(old) {
+		var input databrew.UpdateRecipeInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Send an empty description to remove it.
+		input.Description = aws.String(new.Description.ValueString())
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	if new.needsPublish(old) {
+		if err := publishRecipe(ctx, conn, name); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	publishedVersion, err := findRecipePublishedVersion(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published version", name), err.Error())
+
+		return
+	}
+
+	new.PublishedVersion = fwflex.StringToFramework(ctx, publishedVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	// Published versions must be deleted before the working version.
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	versions, err := findRecipeVersions(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) versions", name), err.Error())
+
+		return
+	}
+
+	for chunk := range slices.Chunk(tfslices.ApplyToAll(versions, func(v awstypes.Recipe) string {
+		return aws.ToString(v.RecipeVersion)
+	}), recipeVersionsMaxBatchSize) {
+		input := databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		}
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &input)
+
+		if err == nil && output != nil {
+			err = errors.Join(tfslices.ApplyToAll(output.Errors, func(v awstypes.RecipeVersionErrorDetail) error {
+				return fmt.Errorf("%s: %s: %s", aws.ToString(v.RecipeVersion), aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+			})...)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s) published versions", name), err.Error())
+
+			return
+		}
+	}
+
+	deleteInput := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	_, err = conn.DeleteRecipeVersion(ctx, &deleteInput)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *recipeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var plan, state recipeResourceModel
+		response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// A new version will be published.
+		if plan.needsPublish(state) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("published_version"), types.StringUnknown())...)
+		}
+	}
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name string) error {
+	input := databrew.PublishRecipeInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.PublishRecipe(ctx, &input)
+
+	return err
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+	output, err := conn.DescribeRecipe(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findRecipePublishedVersion returns the latest published version of the specified recipe, or nil if the recipe has never been published.
+func findRecipePublishedVersion(ctx context.Context, conn *databrew.Client, name string) (*string, error) {
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestPublished)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.RecipeVersion, nil
+}
+
+func findRecipeVersions(ctx context.Context, conn *databrew.Client, input *databrew.ListRecipeVersionsInput) ([]awstypes.Recipe, error) {
+	var output []awstypes.Recipe
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Recipes...)
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                     `tfsdk:"arn" autoflex:"name=ResourceArn"`
+	Description      types.String                                     `tfsdk:"description" autoflex:",omitempty"`
+	Name             types.String                                     `tfsdk:"name"`
+	Publish          types.Bool                                       `tfsdk:"publish" autoflex:"-"`
+	PublishedVersion types.String                                     `tfsdk:"published_version" autoflex:"-"`
+	Steps            fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"steps"`
+	Tags             tftags.Map                                       `tfsdk:"tags"`
+	TagsAll          tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+func (m recipeResourceModel) hasRecipeChanges(old recipeResourceModel) bool {
+	return !m.Description.Equal(old.Description) || !m.Steps.Equal(old.Steps)
+}
+
+// needsPublish returns whether a new recipe version should be published when moving from the old to the new configuration.
+func (m recipeResourceModel) needsPublish(old recipeResourceModel) bool {
+	return m.Publish.ValueBool() && (!old.Publish.ValueBool() || m.hasRecipeChanges(old))
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expressions"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
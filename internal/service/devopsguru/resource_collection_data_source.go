@@ -84,6 +84,6 @@ type resourceCollectionDataSourceModel struct {
 	framework.WithRegionModel
 	CloudFormation fwtypes.ListNestedObjectValueOf[cloudformationData] `tfsdk:"cloudformation"`
 	ID             types.String                                        `tfsdk:"id"`
-	Tags           fwtypes.ListNestedObjectValueOf[tagsData]           `tfsdk:"tags"`
+	Tags           fwtypes.ListNestedObjectValueOf[tagsData]           `tfsdk:"tags"` //lintignore:AWSR006
 	Type           fwtypes.StringEnum[awstypes.ResourceCollectionType] `tfsdk:"type"`
 }
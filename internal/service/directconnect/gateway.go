@@ -30,7 +30,8 @@ import (
 // @Region(global=true)
 // @IdentityAttribute("id")
 // @V60SDKv2Fix
-// @Testing(identityTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/directconnect/types;awstypes;awstypes.DirectConnectGateway")
+// @Testing(randomBgpAsn="64512;65534")
 func resourceGateway() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGatewayCreate,
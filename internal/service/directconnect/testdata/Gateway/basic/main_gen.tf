@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_dx_gateway" "test" {
+  name            = var.rName
+  amazon_side_asn = var.rBgpAsn
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+variable "rBgpAsn" {
+  type     = string
+  nullable = false
+}
+
@@ -60,7 +60,9 @@ func dataSourceAccessEntry() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTags: tftags.TagsSchema(),
+			// Removing tags_all from the data source is a breaking change.
+			//lintignore:AWSR006
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 	}
@@ -17,13 +17,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -419,7 +421,7 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	output, err := conn.DescribeAddon(ctx, input)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
@@ -446,7 +448,7 @@ func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, cluste
 	output, err := conn.DescribeUpdate(ctx, input)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
@@ -463,7 +465,7 @@ func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, cluste
 	return output.Update, nil
 }
 
-func statusAddon(ctx context.Context, conn *eks.Client, clusterName, addonName string) retry.StateRefreshFunc {
+func statusAddon(ctx context.Context, conn *eks.Client, clusterName, addonName string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findAddonByTwoPartKey(ctx, conn, clusterName, addonName)
 
@@ -479,7 +481,7 @@ func statusAddon(ctx context.Context, conn *eks.Client, clusterName, addonName s
 	}
 }
 
-func statusAddonUpdate(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) retry.StateRefreshFunc {
+func statusAddonUpdate(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findAddonUpdateByThreePartKey(ctx, conn, clusterName, addonName, id)
 
@@ -496,14 +498,14 @@ func statusAddonUpdate(ctx context.Context, conn *eks.Client, clusterName, addon
 }
 
 func waitAddonCreated(ctx context.Context, conn *eks.Client, clusterName, addonName string, timeout time.Duration) (*types.Addon, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := sdkretry.StateChangeConf{
 		Pending: enum.Slice(types.AddonStatusCreating, types.AddonStatusDegraded),
 		Target:  enum.Slice(types.AddonStatusActive),
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(&stateConf, addonWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.Addon); ok {
 		if status, health := output.Status, output.Health; status == types.AddonStatusCreateFailed && health != nil {
@@ -517,14 +519,14 @@ func waitAddonCreated(ctx context.Context, conn *eks.Client, clusterName, addonN
 }
 
 func waitAddonDeleted(ctx context.Context, conn *eks.Client, clusterName, addonName string, timeout time.Duration) (*types.Addon, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: enum.Slice(types.AddonStatusActive, types.AddonStatusDeleting),
 		Target:  []string{},
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, addonWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.Addon); ok {
 		if status, health := output.Status, output.Health; status == types.AddonStatusDeleteFailed && health != nil {
@@ -537,15 +539,28 @@ func waitAddonDeleted(ctx context.Context, conn *eks.Client, clusterName, addonN
 	return nil, err
 }
 
+// addonWaitStatus returns the status reported while waiting for an add-on.
+func addonWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*types.Addon); ok && output.Health != nil {
+		if err := addonIssuesError(output.Health.Issues); err != nil {
+			status.Reason = err.Error()
+		}
+	}
+
+	return status
+}
+
 func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, addonName, id string, timeout time.Duration) (*types.Update, error) {
-	stateConf := retry.StateChangeConf{
+	stateConf := sdkretry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
 		Refresh: statusAddonUpdate(ctx, conn, clusterName, addonName, id),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(&stateConf, updateWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
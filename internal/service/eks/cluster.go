@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,14 +16,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -908,17 +911,17 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 	input := eks.DeleteClusterInput{
 		Name: aws.String(d.Id()),
 	}
-	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, timeout, func() *sdkretry.RetryError {
 		var err error
 
 		_, err = conn.DeleteCluster(ctx, &input)
 
 		if errs.IsAErrorMessageContains[*types.ResourceInUseException](err, "in progress") {
-			return retry.RetryableError(err)
+			return sdkretry.RetryableError(err)
 		}
 
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return sdkretry.NonRetryableError(err)
 		}
 
 		return nil
@@ -963,7 +966,7 @@ func findCluster(ctx context.Context, conn *eks.Client, input *eks.DescribeClust
 	// Sometimes the EKS API returns the ResourceNotFound error in this form:
 	// ClientException: No cluster found for name: tf-acc-test-0o1f8
 	if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*types.ClientException](err, "No cluster found for name:") {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
@@ -1035,7 +1038,7 @@ func findUpdate(ctx context.Context, conn *eks.Client, input *eks.DescribeUpdate
 	output, err := conn.DescribeUpdate(ctx, input)
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
@@ -1052,7 +1055,7 @@ func findUpdate(ctx context.Context, conn *eks.Client, input *eks.DescribeUpdate
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
+func statusCluster(ctx context.Context, conn *eks.Client, name string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findClusterByName(ctx, conn, name)
 
@@ -1068,7 +1071,7 @@ func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.Sta
 	}
 }
 
-func statusUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.StateRefreshFunc {
+func statusUpdate(ctx context.Context, conn *eks.Client, name, id string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findUpdateByTwoPartKey(ctx, conn, name, id)
 
@@ -1085,14 +1088,14 @@ func statusUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.
 }
 
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
 		Target:  enum.Slice(types.ClusterStatusActive),
 		Refresh: statusCluster(ctx, conn, name),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, clusterWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		return output, err
@@ -1102,7 +1105,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
 		Target:     []string{},
 		Refresh:    statusCluster(ctx, conn, name),
@@ -1113,7 +1116,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, clusterWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		return output, err
@@ -1122,15 +1125,67 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 	return nil, err
 }
 
+// clusterWaitStatus returns the status reported while waiting for a cluster.
+func clusterWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*types.Cluster); ok && output.Health != nil {
+		if err := clusterIssuesError(output.Health.Issues); err != nil {
+			status.Reason = err.Error()
+		}
+	}
+
+	return status
+}
+
+func clusterIssueError(apiObject types.ClusterIssue) error {
+	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
+}
+
+func clusterIssuesError(apiObjects []types.ClusterIssue) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		err := clusterIssueError(apiObject)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", strings.Join(apiObject.ResourceIds, ", "), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// updateWaitStatus returns the status reported while waiting for a cluster, node group or add-on update.
+func updateWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*types.Update); ok {
+		status.State = string(output.Status)
+
+		if err := errorDetailsError(output.Errors); err != nil {
+			status.Reason = err.Error()
+		} else {
+			var params []string
+			for _, apiObject := range output.Params {
+				params = append(params, fmt.Sprintf("%s: %s", apiObject.Type, aws.ToString(apiObject.Value)))
+			}
+			status.Reason = strings.Join(params, ", ")
+		}
+	}
+
+	return status
+}
+
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
 		Refresh: statusUpdate(ctx, conn, name, id),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, updateWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
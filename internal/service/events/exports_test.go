@@ -29,4 +29,5 @@ var (
 	RuleParseResourceID         = ruleParseResourceID
 	TargetParseImportID         = targetParseImportID
 	TargetStateUpgradeV0        = targetStateUpgradeV0
+	ValidateEventPatternWithAWS = validateEventPatternWithAWS
 )
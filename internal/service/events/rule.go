@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -70,10 +73,11 @@ func resourceRule() *schema.Resource {
 				Default:      DefaultEventBusName,
 			},
 			"event_pattern": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validateEventPatternValue(),
-				AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateEventPatternValue(),
+				AtLeastOneOf:     []string{names.AttrScheduleExpression, "event_pattern"},
+				DiffSuppressFunc: sdkv2.SuppressEquivalentEventPatterns,
 				StateFunc: func(v any) string {
 					json, _ := ruleEventPatternJSONDecoder(v.(string))
 					return json
@@ -141,6 +145,8 @@ func resourceRule() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: ruleEventPatternValidate,
 	}
 }
 
@@ -468,6 +474,52 @@ func expandPutRuleInput(d *schema.ResourceData, name string) *eventbridge.PutRul
 	return apiObject
 }
 
+// ruleEventPatternValidate validates a changed event pattern using the EventBridge API, if enabled by the provider configuration.
+func ruleEventPatternValidate(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	if !c.ValidateWithAWS(ctx) || !d.HasChange("event_pattern") || !d.NewValueKnown("event_pattern") {
+		return nil
+	}
+
+	pattern := d.Get("event_pattern").(string)
+	if pattern == "" {
+		return nil
+	}
+
+	if err := validateEventPatternWithAWS(ctx, c.EventsClient(ctx), pattern, c.AccountID(ctx), c.Region(ctx)); err != nil {
+		return cty.GetAttrPath("event_pattern").NewError(fmt.Errorf("invalid EventBridge Rule event pattern: %w", err))
+	}
+
+	return nil
+}
+
+// validateEventPatternWithAWS validates an event pattern by testing it against a sample event.
+func validateEventPatternWithAWS(ctx context.Context, conn *eventbridge.Client, pattern, accountID, region string) error {
+	event, err := tfjson.EncodeToString(map[string]any{
+		"account":           accountID,
+		"detail":            map[string]any{},
+		"detail-type":       "Terraform Validation",
+		names.AttrID:        "00000000-0000-0000-0000-000000000000",
+		names.AttrRegion:    region,
+		names.AttrResources: []string{},
+		names.AttrSource:    "terraform",
+		"time":              time.Unix(0, 0).UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	input := eventbridge.TestEventPatternInput{
+		Event:        aws.String(event),
+		EventPattern: aws.String(pattern),
+	}
+
+	_, err = conn.TestEventPattern(ctx, &input)
+
+	return err
+}
+
 func validateEventPatternValue() schema.SchemaValidateFunc {
 	return func(v any, k string) (ws []string, errors []error) {
 		json, err := ruleEventPatternJSONDecoder(v.(string))
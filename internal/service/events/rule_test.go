@@ -6,6 +6,7 @@ package events_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
@@ -14,17 +15,104 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateEventPatternWithAWS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response acctest.StubAWSResponse
+		wantErr  bool
+	}{
+		"valid": {
+			response: acctest.StubAWSResponse{
+				StatusCode: http.StatusOK,
+				Body:       `{"Result": false}`,
+			},
+		},
+		"invalid": {
+			response: testEventPatternInvalidResponse,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := acctest.NewStubAWSEndpoint(t, "AWSEvents.TestEventPattern", testCase.response)
+			conn := eventbridge.NewFromConfig(endpoint.Config)
+
+			err := tfevents.ValidateEventPatternWithAWS(t.Context(), conn, `{"source": ["aws.ec2"]}`, acctest.Ct12Digit, endpoint.Config.Region)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ValidateEventPatternWithAWS() err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestRuleCustomizeDiff_validateWithAWS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validateWithAWS bool
+		wantRequests    int
+		wantErr         bool
+	}{
+		"disabled": {},
+		"enabled": {
+			validateWithAWS: true,
+			wantRequests:    1,
+			wantErr:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := acctest.NewStubAWSEndpoint(t, "AWSEvents.TestEventPattern", testEventPatternInvalidResponse)
+			meta := endpoint.AWSClient(testCase.validateWithAWS, names.Events, eventbridge.NewFromConfig(endpoint.Config))
+			config := terraformsdk.NewResourceConfigRaw(map[string]any{
+				"event_pattern": `{"source": [{"prefix": 1}]}`,
+				names.AttrName:  "test",
+			})
+
+			_, err := tfevents.ResourceRule().SimpleDiff(t.Context(), nil, config, meta)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("SimpleDiff() err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				if pathErr, ok := errs.As[cty.PathError](err); !ok || !pathErr.Path.Equals(cty.GetAttrPath("event_pattern")) {
+					t.Errorf("SimpleDiff() err = %v, want error for %q", err, "event_pattern")
+				}
+			}
+			if got, want := endpoint.Requests(), testCase.wantRequests; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+var testEventPatternInvalidResponse = acctest.StubAWSResponse{
+	StatusCode: http.StatusBadRequest,
+	Body:       `{"__type": "InvalidEventPatternException", "message": "Invalid pattern"}`,
+}
+
 func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.EventsServiceID, testAccErrorCheckSkip)
 }
@@ -0,0 +1,826 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_evs_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/evs/types;awstypes;awstypes.Environment")
+// @Testing(importIgnore="host;initial_vlans")
+// Testing is cost-prohibitive
+// @Testing(tagsTest=false, identityTest=false)
+func newEnvironmentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &environmentResource{}
+
+	r.SetDefaultCreateTimeout(6 * time.Hour)
+	r.SetDefaultDeleteTimeout(6 * time.Hour)
+
+	return r, nil
+}
+
+type environmentResource struct {
+	framework.ResourceWithModel[environmentResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *environmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"checks":      framework.ResourceComputedListOfObjectsAttribute[checkModel](ctx),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credentials": framework.ResourceComputedListOfObjectsAttribute[secretModel](ctx, listplanmodifier.UseStateForUnknown()),
+			"environment_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"environment_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentState](),
+				Computed:   true,
+			},
+			"environment_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckResult](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"service_access_security_group_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_access_subnet_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state_details": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"terms_accepted": schema.BoolAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"vcf_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VcfVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"connectivity_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[connectivityInfoModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"private_route_server_peerings": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(2, 2),
+							},
+						},
+					},
+				},
+			},
+			"host": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[hostInfoForCreateModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(4, 16),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"dedicated_host_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"host_name": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrInstanceType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.InstanceType](),
+							Required:   true,
+						},
+						"key_name": schema.StringAttribute{
+							Required: true,
+						},
+						"placement_group_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"initial_vlans": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[initialVLANsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"edge_vtep":        initialVLANInfoBlock(ctx),
+						"expansion_vlan_1": initialVLANInfoBlock(ctx),
+						"expansion_vlan_2": initialVLANInfoBlock(ctx),
+						"hcx":              initialVLANInfoBlock(ctx),
+						"nsx_uplink":       initialVLANInfoBlock(ctx),
+						"vmk_management":   initialVLANInfoBlock(ctx),
+						"vm_management":    initialVLANInfoBlock(ctx),
+						"vmotion":          initialVLANInfoBlock(ctx),
+						"vsan":             initialVLANInfoBlock(ctx),
+						"vtep":             initialVLANInfoBlock(ctx),
+					},
+				},
+			},
+			"license_info": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[licenseInfoModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"solution_key": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+						"vsan_key": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"vcf_hostnames": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vcfHostnamesModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_builder": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_edge_1": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_edge_2": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_1": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_2": schema.StringAttribute{
+							Required: true,
+						},
+						"nsx_manager_3": schema.StringAttribute{
+							Required: true,
+						},
+						"sddc_manager": schema.StringAttribute{
+							Required: true,
+						},
+						"vcenter": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func initialVLANInfoBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[initialVLANInfoModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr": schema.StringAttribute{
+					CustomType: fwtypes.CIDRBlockType,
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func (r *environmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	var input evs.CreateEnvironmentInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	if v := data.ServiceAccessSecurityGroupIDs; !v.IsNull() && !v.IsUnknown() {
+		input.ServiceAccessSecurityGroups = &awstypes.ServiceAccessSecurityGroups{
+			SecurityGroups: fwflex.ExpandFrameworkStringValueSet(ctx, v),
+		}
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateEnvironment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EVS Environment", err.Error())
+
+		return
+	}
+
+	environmentID := aws.ToString(output.Environment.EnvironmentId)
+	data.ID = fwflex.StringValueToFramework(ctx, environmentID)
+
+	environment, err := waitEnvironmentCreated(ctx, conn, environmentID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), environmentID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EVS Environment (%s) create", environmentID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, environment, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.flattenServiceAccessSecurityGroups(ctx, environment.ServiceAccessSecurityGroups)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *environmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findEnvironmentByID(ctx, conn, environmentID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EVS Environment (%s)", environmentID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.flattenServiceAccessSecurityGroups(ctx, output.ServiceAccessSecurityGroups)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *environmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new environmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *environmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data environmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EVSClient(ctx)
+
+	environmentID := fwflex.StringValueFromFramework(ctx, data.ID)
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+	start := time.Now()
+
+	// An environment can only be deleted once all of its hosts have been deleted.
+	if err := deleteEnvironmentHosts(ctx, conn, environmentID, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EVS Environment (%s) hosts", environmentID), err.Error())
+
+		return
+	}
+
+	input := evs.DeleteEnvironmentInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		EnvironmentId: aws.String(environmentID),
+	}
+	_, err := conn.DeleteEnvironment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EVS Environment (%s)", environmentID), err.Error())
+
+		return
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, environmentID, timeout-time.Since(start)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EVS Environment (%s) delete", environmentID), err.Error())
+
+		return
+	}
+}
+
+// deleteEnvironmentHosts deletes all of the specified environment's hosts and waits for the deletions to complete.
+func deleteEnvironmentHosts(ctx context.Context, conn *evs.Client, environmentID string, timeout time.Duration) error {
+	hosts, err := findEnvironmentHostsByEnvironmentID(ctx, conn, environmentID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	for _, v := range hosts {
+		if v.HostState == awstypes.HostStateDeleting {
+			continue
+		}
+
+		hostName := aws.ToString(v.HostName)
+		input := evs.DeleteEnvironmentHostInput{
+			ClientToken:   aws.String(id.UniqueId()),
+			EnvironmentId: aws.String(environmentID),
+			HostName:      aws.String(hostName),
+		}
+		_, err := conn.DeleteEnvironmentHost(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting host (%s): %w", hostName, err)
+		}
+	}
+
+	if _, err := waitEnvironmentHostsDeleted(ctx, conn, environmentID, len(hosts), timeout); err != nil {
+		return fmt.Errorf("waiting for hosts delete: %w", err)
+	}
+
+	return nil
+}
+
+func findEnvironmentByID(ctx context.Context, conn *evs.Client, id string) (*awstypes.Environment, error) {
+	input := evs.GetEnvironmentInput{
+		EnvironmentId: aws.String(id),
+	}
+	output, err := conn.GetEnvironment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Environment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.Environment.EnvironmentState; state == awstypes.EnvironmentStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output.Environment, nil
+}
+
+func findEnvironmentHostsByEnvironmentID(ctx context.Context, conn *evs.Client, id string) ([]awstypes.Host, error) {
+	input := evs.ListEnvironmentHostsInput{
+		EnvironmentId: aws.String(id),
+	}
+
+	return findEnvironmentHosts(ctx, conn, &input, func(v *awstypes.Host) bool {
+		return v.HostState != awstypes.HostStateDeleted
+	})
+}
+
+func findEnvironmentHosts(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentHostsInput, filter tfslices.Predicate[*awstypes.Host]) ([]awstypes.Host, error) {
+	var output []awstypes.Host
+
+	pages := evs.NewListEnvironmentHostsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EnvironmentHosts {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findEnvironmentVLANs(ctx context.Context, conn *evs.Client, input *evs.ListEnvironmentVlansInput) ([]awstypes.Vlan, error) {
+	var output []awstypes.Vlan
+
+	pages := evs.NewListEnvironmentVlansPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EnvironmentVlans...)
+	}
+
+	return output, nil
+}
+
+func statusEnvironment(conn *evs.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.EnvironmentState), nil
+	}
+}
+
+// statusEnvironmentHosts returns the environment's remaining hosts.
+func statusEnvironmentHosts(conn *evs.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findEnvironmentHostsByEnvironmentID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output) == 0 {
+			return nil, "", nil
+		}
+
+		return output, string(awstypes.HostStateDeleting), nil
+	}
+}
+
+// environmentStatus reports the environment's state details while waiting.
+func environmentStatus(v any) backoff.Status {
+	var status backoff.Status
+	if output, ok := v.(*awstypes.Environment); ok {
+		status.Reason = aws.ToString(output.StateDetails)
+	}
+	return status
+}
+
+// VMware Cloud Foundation deployments take several hours, so poll and report progress less often than the defaults.
+const (
+	environmentPollInterval     = 1 * time.Minute
+	environmentProgressInterval = 5 * time.Minute
+)
+
+func waitEnvironmentCreated(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:          enum.Slice(awstypes.EnvironmentStateCreating),
+		Target:           enum.Slice(awstypes.EnvironmentStateCreated),
+		Refresh:          statusEnvironment(conn, id),
+		Timeout:          timeout,
+		PollInterval:     environmentPollInterval,
+		Status:           environmentStatus,
+		ProgressInterval: environmentProgressInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *evs.Client, id string, timeout time.Duration) (*awstypes.Environment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:          enum.Slice(awstypes.EnvironmentStateDeleting),
+		Target:           []string{},
+		Refresh:          statusEnvironment(conn, id),
+		Timeout:          timeout,
+		PollInterval:     environmentPollInterval,
+		Status:           environmentStatus,
+		ProgressInterval: environmentProgressInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Environment); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentHostsDeleted(ctx context.Context, conn *evs.Client, id string, count int, timeout time.Duration) ([]awstypes.Host, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.HostStateDeleting),
+		Target:       []string{},
+		Refresh:      statusEnvironmentHosts(conn, id),
+		Timeout:      timeout,
+		PollInterval: environmentPollInterval,
+		Status: func(v any) backoff.Status {
+			var status backoff.Status
+			if output, ok := v.([]awstypes.Host); ok && count > 0 {
+				status.PercentComplete = aws.Int(100 * max(count-len(output), 0) / count)
+				status.Reason = fmt.Sprintf("%d of %d hosts remaining", len(output), count)
+			}
+			return status
+		},
+		ProgressInterval: environmentProgressInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.Host); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output, func(v awstypes.Host) error {
+			return fmt.Errorf("%s: %s", aws.ToString(v.HostName), aws.ToString(v.StateDetails))
+		})...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type environmentResourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                            `tfsdk:"arn" autoflex:"name=EnvironmentArn"`
+	Checks                        fwtypes.ListNestedObjectValueOf[checkModel]             `tfsdk:"checks"`
+	ConnectivityInfo              fwtypes.ListNestedObjectValueOf[connectivityInfoModel]  `tfsdk:"connectivity_info"`
+	CreatedAt                     timetypes.RFC3339                                       `tfsdk:"created_at"`
+	Credentials                   fwtypes.ListNestedObjectValueOf[secretModel]            `tfsdk:"credentials"`
+	EnvironmentName               types.String                                            `tfsdk:"environment_name"`
+	EnvironmentState              fwtypes.StringEnum[awstypes.EnvironmentState]           `tfsdk:"environment_state"`
+	EnvironmentStatus             fwtypes.StringEnum[awstypes.CheckResult]                `tfsdk:"environment_status"`
+	Hosts                         fwtypes.ListNestedObjectValueOf[hostInfoForCreateModel] `tfsdk:"host"`
+	ID                            types.String                                            `tfsdk:"id" autoflex:"name=EnvironmentId"`
+	InitialVLANs                  fwtypes.ListNestedObjectValueOf[initialVLANsModel]      `tfsdk:"initial_vlans"`
+	KMSKeyID                      types.String                                            `tfsdk:"kms_key_id"`
+	LicenseInfo                   fwtypes.ListNestedObjectValueOf[licenseInfoModel]       `tfsdk:"license_info"`
+	ModifiedAt                    timetypes.RFC3339                                       `tfsdk:"modified_at"`
+	ServiceAccessSecurityGroupIDs fwtypes.SetOfString                                     `tfsdk:"service_access_security_group_ids" autoflex:"-"`
+	ServiceAccessSubnetID         types.String                                            `tfsdk:"service_access_subnet_id"`
+	SiteID                        types.String                                            `tfsdk:"site_id"`
+	StateDetails                  types.String                                            `tfsdk:"state_details"`
+	Tags                          tftags.Map                                              `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                              `tfsdk:"tags_all"`
+	TermsAccepted                 types.Bool                                              `tfsdk:"terms_accepted"`
+	Timeouts                      timeouts.Value                                          `tfsdk:"timeouts"`
+	VCFHostnames                  fwtypes.ListNestedObjectValueOf[vcfHostnamesModel]      `tfsdk:"vcf_hostnames"`
+	VCFVersion                    fwtypes.StringEnum[awstypes.VcfVersion]                 `tfsdk:"vcf_version"`
+	VPCID                         types.String                                            `tfsdk:"vpc_id"`
+}
+
+func (m *environmentResourceModel) flattenServiceAccessSecurityGroups(ctx context.Context, apiObject *awstypes.ServiceAccessSecurityGroups) {
+	if apiObject == nil {
+		m.ServiceAccessSecurityGroupIDs = fwtypes.NewSetValueOfNull[types.String](ctx)
+		return
+	}
+
+	m.ServiceAccessSecurityGroupIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.SecurityGroups)
+}
+
+type checkModel struct {
+	ImpairedSince timetypes.RFC3339                        `tfsdk:"impaired_since"`
+	Result        fwtypes.StringEnum[awstypes.CheckResult] `tfsdk:"result"`
+	Type          fwtypes.StringEnum[awstypes.CheckType]   `tfsdk:"type"`
+}
+
+type connectivityInfoModel struct {
+	PrivateRouteServerPeerings fwtypes.SetOfString `tfsdk:"private_route_server_peerings"`
+}
+
+type hostInfoForCreateModel struct {
+	DedicatedHostID  types.String                              `tfsdk:"dedicated_host_id"`
+	HostName         types.String                              `tfsdk:"host_name"`
+	InstanceType     fwtypes.StringEnum[awstypes.InstanceType] `tfsdk:"instance_type"`
+	KeyName          types.String                              `tfsdk:"key_name"`
+	PlacementGroupID types.String                              `tfsdk:"placement_group_id"`
+}
+
+type initialVLANsModel struct {
+	EdgeVTep       fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"edge_vtep"`
+	ExpansionVLAN1 fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"expansion_vlan_1"`
+	ExpansionVLAN2 fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"expansion_vlan_2"`
+	HCX            fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"hcx"`
+	NSXUplink      fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"nsx_uplink"`
+	VMKManagement  fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"vmk_management"`
+	VMManagement   fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"vm_management"`
+	VMotion        fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"vmotion"`
+	VSan           fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"vsan"`
+	VTep           fwtypes.ListNestedObjectValueOf[initialVLANInfoModel] `tfsdk:"vtep"`
+}
+
+type initialVLANInfoModel struct {
+	CIDR fwtypes.CIDRBlock `tfsdk:"cidr"`
+}
+
+type licenseInfoModel struct {
+	SolutionKey types.String `tfsdk:"solution_key"`
+	VSANKey     types.String `tfsdk:"vsan_key"`
+}
+
+type secretModel struct {
+	SecretARN types.String `tfsdk:"secret_arn"`
+}
+
+type vcfHostnamesModel struct {
+	CloudBuilder types.String `tfsdk:"cloud_builder"`
+	NSX          types.String `tfsdk:"nsx"`
+	NSXEdge1     types.String `tfsdk:"nsx_edge_1"`
+	NSXEdge2     types.String `tfsdk:"nsx_edge_2"`
+	NSXManager1  types.String `tfsdk:"nsx_manager_1"`
+	NSXManager2  types.String `tfsdk:"nsx_manager_2"`
+	NSXManager3  types.String `tfsdk:"nsx_manager_3"`
+	SDDCManager  types.String `tfsdk:"sddc_manager"`
+	VCenter      types.String `tfsdk:"vcenter"`
+}
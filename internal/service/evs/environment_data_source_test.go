@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEVSEnvironmentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	environmentID := acctest.SkipIfEnvVarNotSet(t, "EVS_ENVIRONMENT_ID")
+	dataSourceName := "data.aws_evs_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentDataSourceConfig_basic(environmentID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "environment_id", environmentID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "environment_state"),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "hosts.#", 4),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hosts.0.host_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vcf_version"),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "vlans.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vlans.0.cidr"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrVPCID),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentDataSourceConfig_basic(environmentID string) string {
+	return fmt.Sprintf(`
+data "aws_evs_environment" "test" {
+  environment_id = %[1]q
+}
+`, environmentID)
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package evs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/evs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/evs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevs "github.com/hashicorp/terraform-provider-aws/internal/service/evs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testAccEnvironmentSettings holds the pre-existing resources and license details required to create an environment.
+type testAccEnvironmentSettings struct {
+	routeServerPeerID1    string
+	routeServerPeerID2    string
+	serviceAccessSubnetID string
+	siteID                string
+	solutionKey           string
+	vpcID                 string
+	vsanKey               string
+}
+
+func testAccEnvironmentSettingsFromEnv(t *testing.T) testAccEnvironmentSettings {
+	t.Helper()
+
+	return testAccEnvironmentSettings{
+		routeServerPeerID1:    acctest.SkipIfEnvVarNotSet(t, "EVS_ROUTE_SERVER_PEER_ID_1"),
+		routeServerPeerID2:    acctest.SkipIfEnvVarNotSet(t, "EVS_ROUTE_SERVER_PEER_ID_2"),
+		serviceAccessSubnetID: acctest.SkipIfEnvVarNotSet(t, "EVS_SERVICE_ACCESS_SUBNET_ID"),
+		siteID:                acctest.SkipIfEnvVarNotSet(t, "EVS_SITE_ID"),
+		solutionKey:           acctest.SkipIfEnvVarNotSet(t, "EVS_SOLUTION_KEY"),
+		vpcID:                 acctest.SkipIfEnvVarNotSet(t, "EVS_VPC_ID"),
+		vsanKey:               acctest.SkipIfEnvVarNotSet(t, "EVS_VSAN_KEY"),
+	}
+}
+
+func TestAccEVSEnvironment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	settings := testAccEnvironmentSettingsFromEnv(t)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, publicKey, settings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "evs", regexache.MustCompile(`environment/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_info.0.private_route_server_peerings.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "environment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "environment_state", string(awstypes.EnvironmentStateCreated)),
+					resource.TestCheckResourceAttr(resourceName, "host.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "license_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_access_subnet_id", settings.serviceAccessSubnetID),
+					resource.TestCheckResourceAttr(resourceName, "site_id", settings.siteID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "terms_accepted", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "vcf_hostnames.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vcf_version", string(awstypes.VcfVersionVcf521)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVPCID, settings.vpcID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"host", "initial_vlans"},
+			},
+		},
+	})
+}
+
+func TestAccEVSEnvironment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	settings := testAccEnvironmentSettingsFromEnv(t)
+	var v awstypes.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evs_environment.test"
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, publicKey, settings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfevs.ResourceEnvironment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_evs_environment" {
+				continue
+			}
+
+			_, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EVS Environment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentExists(ctx context.Context, n string, v *awstypes.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+		output, err := tfevs.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EVSClient(ctx)
+
+	input := evs.ListEnvironmentsInput{}
+	_, err := conn.ListEnvironments(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccEnvironmentConfig_basic(rName, publicKey string, settings testAccEnvironmentSettings) string {
+	return fmt.Sprintf(`
+resource "aws_key_pair" "test" {
+  key_name   = %[1]q
+  public_key = %[2]q
+}
+
+resource "aws_evs_environment" "test" {
+  environment_name         = %[1]q
+  site_id                  = %[3]q
+  terms_accepted           = true
+  vcf_version              = "VCF-5.2.1"
+  vpc_id                   = %[4]q
+  service_access_subnet_id = %[5]q
+
+  connectivity_info {
+    private_route_server_peerings = [%[6]q, %[7]q]
+  }
+
+  license_info {
+    solution_key = %[8]q
+    vsan_key     = %[9]q
+  }
+
+  initial_vlans {
+    vmk_management {
+      cidr = "10.10.0.0/24"
+    }
+    vm_management {
+      cidr = "10.10.1.0/24"
+    }
+    vmotion {
+      cidr = "10.10.2.0/24"
+    }
+    vsan {
+      cidr = "10.10.3.0/24"
+    }
+    vtep {
+      cidr = "10.10.4.0/24"
+    }
+    edge_vtep {
+      cidr = "10.10.5.0/24"
+    }
+    nsx_uplink {
+      cidr = "10.10.6.0/24"
+    }
+    hcx {
+      cidr = "10.10.7.0/24"
+    }
+    expansion_vlan_1 {
+      cidr = "10.10.8.0/24"
+    }
+    expansion_vlan_2 {
+      cidr = "10.10.9.0/24"
+    }
+  }
+
+  host {
+    host_name     = "esxi-host-01"
+    instance_type = "i4i.metal"
+    key_name      = aws_key_pair.test.key_name
+  }
+
+  host {
+    host_name     = "esxi-host-02"
+    instance_type = "i4i.metal"
+    key_name      = aws_key_pair.test.key_name
+  }
+
+  host {
+    host_name     = "esxi-host-03"
+    instance_type = "i4i.metal"
+    key_name      = aws_key_pair.test.key_name
+  }
+
+  host {
+    host_name     = "esxi-host-04"
+    instance_type = "i4i.metal"
+    key_name      = aws_key_pair.test.key_name
+  }
+
+  vcf_hostnames {
+    vcenter       = "vcf-vc01"
+    nsx           = "vcf-nsx"
+    nsx_manager_1 = "vcf-nsxm01"
+    nsx_manager_2 = "vcf-nsxm02"
+    nsx_manager_3 = "vcf-nsxm03"
+    nsx_edge_1    = "vcf-edge01"
+    nsx_edge_2    = "vcf-edge02"
+    sddc_manager  = "vcf-sddcm01"
+    cloud_builder = "vcf-cb01"
+  }
+}
+`, rName, publicKey, settings.siteID, settings.vpcID, settings.serviceAccessSubnetID, settings.routeServerPeerID1, settings.routeServerPeerID2, settings.solutionKey, settings.vsanKey)
+}
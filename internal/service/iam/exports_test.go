@@ -60,5 +60,6 @@ var (
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 
-	RolePolicyParseID = rolePolicyParseID
+	RolePolicyParseID     = rolePolicyParseID
+	ValidatePolicyWithAWS = validatePolicyWithAWS
 )
@@ -5,14 +5,19 @@ package iam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
 	"reflect"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -100,6 +105,8 @@ func resourcePolicy() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: policyDocumentValidate,
 	}
 }
 
@@ -482,3 +489,53 @@ func policyTags(ctx context.Context, conn *iam.Client, identifier string, optFns
 
 	return output, nil
 }
+
+// policyDocumentValidate validates a changed policy document using the IAM Access Analyzer API, if enabled by the provider configuration.
+func policyDocumentValidate(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	if !c.ValidateWithAWS(ctx) || !d.HasChange(names.AttrPolicy) || !d.NewValueKnown(names.AttrPolicy) {
+		return nil
+	}
+
+	if err := validatePolicyWithAWS(ctx, c.AccessAnalyzerClient(ctx), d.Get(names.AttrPolicy).(string), accessanalyzertypes.PolicyTypeIdentityPolicy); err != nil {
+		return cty.GetAttrPath(names.AttrPolicy).NewError(fmt.Errorf("invalid IAM Policy document: %w", err))
+	}
+
+	return nil
+}
+
+// validatePolicyWithAWS returns an error for each IAM Access Analyzer policy validation finding of type ERROR.
+// Findings of other types, such as security warnings and suggestions, are logged as warnings.
+func validatePolicyWithAWS(ctx context.Context, conn *accessanalyzer.Client, document string, policyType accessanalyzertypes.PolicyType) error {
+	input := accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(document),
+		PolicyType:     policyType,
+	}
+
+	var findings []error
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Findings {
+			if v.FindingType != accessanalyzertypes.ValidatePolicyFindingTypeError {
+				tflog.Warn(ctx, "IAM Access Analyzer policy validation finding", map[string]any{
+					"finding_details": aws.ToString(v.FindingDetails),
+					"finding_type":    v.FindingType,
+					"issue_code":      aws.ToString(v.IssueCode),
+					"learn_more_link": aws.ToString(v.LearnMoreLink),
+				})
+				continue
+			}
+
+			findings = append(findings, fmt.Errorf("%s (%s): %s", v.FindingType, aws.ToString(v.IssueCode), aws.ToString(v.FindingDetails)))
+		}
+	}
+
+	return errors.Join(findings...)
+}
@@ -6,21 +6,124 @@ package iam_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/go-cty/cty"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidatePolicyWithAWS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response acctest.StubAWSResponse
+		wantErr  bool
+	}{
+		"no findings": {
+			response: acctest.StubAWSResponse{
+				StatusCode: http.StatusOK,
+				Body:       `{"findings": []}`,
+			},
+		},
+		"suggestion": {
+			response: acctest.StubAWSResponse{
+				StatusCode: http.StatusOK,
+				Body:       `{"findings": [{"findingType": "SUGGESTION", "issueCode": "EMPTY_ARRAY_ACTION", "findingDetails": "Add a value to the empty array.", "learnMoreLink": "https://example.com", "locations": []}]}`,
+			},
+		},
+		"error": {
+			response: testPolicyValidationErrorResponse,
+			wantErr:  true,
+		},
+		"API error": {
+			response: acctest.StubAWSResponse{
+				StatusCode: http.StatusBadRequest,
+				Header:     map[string]string{"X-Amzn-ErrorType": "ValidationException"},
+				Body:       `{"message": "Invalid policy document"}`,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := acctest.NewStubAWSEndpoint(t, "/policy/validation", testCase.response)
+			conn := accessanalyzer.NewFromConfig(endpoint.Config)
+
+			err := tfiam.ValidatePolicyWithAWS(t.Context(), conn, `{"Version": "2012-10-17", "Statement": []}`, accessanalyzertypes.PolicyTypeIdentityPolicy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ValidatePolicyWithAWS() err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestPolicyCustomizeDiff_validateWithAWS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validateWithAWS bool
+		wantRequests    int
+		wantErr         bool
+	}{
+		"disabled": {},
+		"enabled": {
+			validateWithAWS: true,
+			wantRequests:    1,
+			wantErr:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := acctest.NewStubAWSEndpoint(t, "/policy/validation", testPolicyValidationErrorResponse)
+			meta := endpoint.AWSClient(testCase.validateWithAWS, names.AccessAnalyzer, accessanalyzer.NewFromConfig(endpoint.Config))
+			config := terraformsdk.NewResourceConfigRaw(map[string]any{
+				names.AttrPolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Resource": "*"}]}`,
+			})
+
+			_, err := tfiam.ResourcePolicy().SimpleDiff(t.Context(), nil, config, meta)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("SimpleDiff() err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				if pathErr, ok := errs.As[cty.PathError](err); !ok || !pathErr.Path.Equals(cty.GetAttrPath(names.AttrPolicy)) {
+					t.Errorf("SimpleDiff() err = %v, want error for %q", err, names.AttrPolicy)
+				}
+			}
+			if got, want := endpoint.Requests(), testCase.wantRequests; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+var testPolicyValidationErrorResponse = acctest.StubAWSResponse{
+	StatusCode: http.StatusOK,
+	Body:       `{"findings": [{"findingType": "ERROR", "issueCode": "MISSING_ACTION", "findingDetails": "Add an Action or NotAction element to the policy statement.", "learnMoreLink": "https://example.com", "locations": []}]}`,
+}
+
 func TestAccIAMPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var out awstypes.Policy
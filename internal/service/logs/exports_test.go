@@ -45,4 +45,5 @@ var (
 	ValidLogMetricFilterName               = validLogMetricFilterName
 	ValidLogMetricFilterTransformationName = validLogMetricFilterTransformationName
 	ValidLogStreamName                     = validLogStreamName // nosemgrep:ci.logs-in-var-name
+	ValidateFilterPatternWithAWS           = validateFilterPatternWithAWS
 )
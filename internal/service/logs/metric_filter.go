@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -111,6 +112,8 @@ func resourceMetricFilter() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: metricFilterPatternValidate,
 	}
 }
 
@@ -375,3 +378,35 @@ func flattenMetricTransformations(apiObjects []awstypes.MetricTransformation) []
 
 	return tfList
 }
+
+// metricFilterPatternValidate validates a changed filter pattern using the CloudWatch Logs API, if enabled by the provider configuration.
+func metricFilterPatternValidate(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	if !c.ValidateWithAWS(ctx) || !d.HasChange("pattern") || !d.NewValueKnown("pattern") {
+		return nil
+	}
+
+	pattern := strings.TrimSpace(d.Get("pattern").(string))
+	if pattern == "" {
+		return nil
+	}
+
+	if err := validateFilterPatternWithAWS(ctx, c.LogsClient(ctx), pattern); err != nil {
+		return cty.GetAttrPath("pattern").NewError(fmt.Errorf("invalid CloudWatch Logs Metric Filter pattern: %w", err))
+	}
+
+	return nil
+}
+
+// validateFilterPatternWithAWS validates a filter pattern by testing it against a sample log event message.
+func validateFilterPatternWithAWS(ctx context.Context, conn *cloudwatchlogs.Client, pattern string) error {
+	input := cloudwatchlogs.TestMetricFilterInput{
+		FilterPattern:    aws.String(pattern),
+		LogEventMessages: []string{"Terraform Validation"},
+	}
+
+	_, err := conn.TestMetricFilter(ctx, &input)
+
+	return err
+}
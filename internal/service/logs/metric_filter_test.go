@@ -6,17 +6,114 @@ package logs_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/go-cty/cty"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateFilterPatternWithAWS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response acctest.StubAWSResponse
+		wantErr  bool
+	}{
+		"valid": {
+			response: acctest.StubAWSResponse{
+				StatusCode: http.StatusOK,
+				Body:       `{"matches": []}`,
+			},
+		},
+		"invalid": {
+			response: testFilterPatternInvalidResponse,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := acctest.NewStubAWSEndpoint(t, "Logs_20140328.TestMetricFilter", testCase.response)
+			conn := cloudwatchlogs.NewFromConfig(endpoint.Config)
+
+			err := tflogs.ValidateFilterPatternWithAWS(t.Context(), conn, `{ $.errorCode = "AccessDenied" }`)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("ValidateFilterPatternWithAWS() err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestMetricFilterCustomizeDiff_validateWithAWS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validateWithAWS bool
+		wantRequests    int
+		wantErr         bool
+	}{
+		"disabled": {},
+		"enabled": {
+			validateWithAWS: true,
+			wantRequests:    1,
+			wantErr:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := acctest.NewStubAWSEndpoint(t, "Logs_20140328.TestMetricFilter", testFilterPatternInvalidResponse)
+			meta := endpoint.AWSClient(testCase.validateWithAWS, names.Logs, cloudwatchlogs.NewFromConfig(endpoint.Config))
+			config := terraformsdk.NewResourceConfigRaw(map[string]any{
+				names.AttrLogGroupName: "test",
+				"metric_transformation": []any{
+					map[string]any{
+						names.AttrName:      "test",
+						names.AttrNamespace: "test",
+						names.AttrValue:     "1",
+					},
+				},
+				names.AttrName: "test",
+				"pattern":      `{ $.errorCode = `,
+			})
+
+			_, err := tflogs.ResourceMetricFilter().SimpleDiff(t.Context(), nil, config, meta)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("SimpleDiff() err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				if pathErr, ok := errs.As[cty.PathError](err); !ok || !pathErr.Path.Equals(cty.GetAttrPath("pattern")) {
+					t.Errorf("SimpleDiff() err = %v, want error for %q", err, "pattern")
+				}
+			}
+			if got, want := endpoint.Requests(), testCase.wantRequests; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+var testFilterPatternInvalidResponse = acctest.StubAWSResponse{
+	StatusCode: http.StatusBadRequest,
+	Body:       `{"__type": "InvalidParameterException", "message": "Invalid pattern"}`,
+}
+
 func TestAccLogsMetricFilter_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var mf types.MetricFilter
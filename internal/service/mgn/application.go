@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Application")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &applicationResource{}, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithModel[applicationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"source_server_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input mgn.CreateApplicationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplication(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MGN Application (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.ApplicationID)
+
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, data.SourceServerIDs); len(v) > 0 {
+		input := mgn.AssociateSourceServersInput{
+			ApplicationID:   aws.String(id),
+			SourceServerIDs: v,
+		}
+		_, err := conn.AssociateSourceServers(ctx, &input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("associating MGN Application (%s) source servers", id), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findApplicationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Application (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sourceServerIDs, err := findSourceServerIDsByApplicationID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MGN Application (%s) source servers", id), err.Error())
+
+		return
+	}
+
+	data.SourceServerIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, sourceServerIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, new.ID)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := mgn.UpdateApplicationInput{
+			ApplicationID: aws.String(id),
+			Description:   aws.String(new.Description.ValueString()),
+			Name:          fwflex.StringFromFramework(ctx, new.Name),
+		}
+		_, err := conn.UpdateApplication(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MGN Application (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.SourceServerIDs.Equal(old.SourceServerIDs) {
+		oldIDs, newIDs := fwflex.ExpandFrameworkStringValueSet(ctx, old.SourceServerIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.SourceServerIDs)
+
+		if del := oldIDs.Difference(newIDs); len(del) > 0 {
+			if err := disassociateSourceServers(ctx, conn, id, del); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating MGN Application (%s) source servers", id), err.Error())
+
+				return
+			}
+		}
+
+		if add := newIDs.Difference(oldIDs); len(add) > 0 {
+			input := mgn.AssociateSourceServersInput{
+				ApplicationID:   aws.String(id),
+				SourceServerIDs: add,
+			}
+			_, err := conn.AssociateSourceServers(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating MGN Application (%s) source servers", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, data.ID)
+
+	// Source servers must be disassociated before the application can be deleted.
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, data.SourceServerIDs); len(v) > 0 {
+		if err := disassociateSourceServers(ctx, conn, id, v); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("disassociating MGN Application (%s) source servers", id), err.Error())
+
+			return
+		}
+	}
+
+	input := mgn.DeleteApplicationInput{
+		ApplicationID: aws.String(id),
+	}
+	_, err := conn.DeleteApplication(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MGN Application (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func disassociateSourceServers(ctx context.Context, conn *mgn.Client, applicationID string, sourceServerIDs []string) error {
+	input := mgn.DisassociateSourceServersInput{
+		ApplicationID:   aws.String(applicationID),
+		SourceServerIDs: sourceServerIDs,
+	}
+	_, err := conn.DisassociateSourceServers(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findApplicationByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Application, error) {
+	input := mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			ApplicationIDs: []string{id},
+		},
+	}
+
+	return findApplication(ctx, conn, &input)
+}
+
+func findApplication(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) (*awstypes.Application, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findApplications(ctx context.Context, conn *mgn.Client, input *mgn.ListApplicationsInput) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	pages := mgn.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findSourceServerIDsByApplicationID(ctx context.Context, conn *mgn.Client, id string) ([]string, error) {
+	input := mgn.DescribeSourceServersInput{
+		Filters: &awstypes.DescribeSourceServersRequestFilters{
+			ApplicationIDs: []string{id},
+		},
+	}
+	output, err := findSourceServers(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.SourceServer) string {
+		return aws.ToString(v.SourceServerID)
+	}), nil
+}
+
+func findSourceServers(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := mgn.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type applicationResourceModel struct {
+	framework.WithRegionModel
+	ARN             types.String        `tfsdk:"arn"`
+	Description     types.String        `tfsdk:"description" autoflex:",omitempty"`
+	ID              types.String        `tfsdk:"id" autoflex:"name=ApplicationID"`
+	Name            types.String        `tfsdk:"name"`
+	SourceServerIDs fwtypes.SetOfString `tfsdk:"source_server_ids" autoflex:"-"`
+	Tags            tftags.Map          `tfsdk:"tags"`
+	TagsAll         tftags.Map          `tfsdk:"tags_all"`
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                      = newApplicationResource
+	ResourceLaunchConfigurationTemplate      = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceWave                             = newWaveResource
+
+	FindApplicationByID                          = findApplicationByID
+	FindLaunchConfigurationBySourceServerID      = findLaunchConfigurationBySourceServerID
+	FindLaunchConfigurationTemplateByID          = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationBySourceServerID = findReplicationConfigurationBySourceServerID
+	FindReplicationConfigurationTemplateByID     = findReplicationConfigurationTemplateByID
+	FindWaveByID                                 = findWaveByID
+)
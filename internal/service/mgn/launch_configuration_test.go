@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnLaunchConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, "MGN_SOURCE_SERVER_ID")
+	resourceName := "aws_mgn_launch_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationConfig_basic(sourceServerID, "STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        sourceServerID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "source_server_id",
+				ImportStateVerifyIgnore:              []string{"licensing", "post_launch_actions"},
+			},
+			{
+				Config: testAccLaunchConfigurationConfig_basic(sourceServerID, "STARTED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		_, err := tfmgn.FindLaunchConfigurationBySourceServerID(ctx, conn, rs.Primary.Attributes["source_server_id"])
+
+		return err
+	}
+}
+
+func testAccLaunchConfigurationConfig_basic(sourceServerID, launchDisposition string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_launch_configuration" "test" {
+  launch_disposition = %[2]q
+  source_server_id   = %[1]q
+}
+`, sourceServerID, launchDisposition)
+}
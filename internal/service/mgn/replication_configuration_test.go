@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnReplicationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, "MGN_SOURCE_SERVER_ID")
+	resourceName := "aws_mgn_replication_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_basic(sourceServerID, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "data_plane_routing"),
+					resource.TestCheckResourceAttr(resourceName, "replicated_disk.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        sourceServerID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "source_server_id",
+				ImportStateVerifyIgnore:              []string{"replicated_disk"},
+			},
+			{
+				Config: testAccReplicationConfigurationConfig_basic(sourceServerID, "t3.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.medium"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		_, err := tfmgn.FindReplicationConfigurationBySourceServerID(ctx, conn, rs.Primary.Attributes["source_server_id"])
+
+		return err
+	}
+}
+
+func testAccReplicationConfigurationConfig_basic(sourceServerID, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_replication_configuration" "test" {
+  replication_server_instance_type = %[2]q
+  source_server_id                 = %[1]q
+}
+`, sourceServerID, instanceType)
+}
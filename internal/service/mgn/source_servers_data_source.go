@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_mgn_source_servers", name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithModel[sourceServersDataSourceModel]
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"is_archived": schema.BoolAttribute{
+				Optional: true,
+			},
+			"lifecycle_states": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringEnumType[awstypes.LifeCycleState](),
+				ElementType: fwtypes.StringEnumType[awstypes.LifeCycleState](),
+				Optional:    true,
+			},
+			"replication_types": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringEnumType[awstypes.ReplicationType](),
+				ElementType: fwtypes.StringEnumType[awstypes.ReplicationType](),
+				Optional:    true,
+			},
+			"source_server_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_servers": framework.DataSourceComputedListOfObjectAttribute[sourceServerModel](ctx),
+			names.AttrTags:   tftags.TagsAttribute(),
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().MgnClient(ctx)
+
+	var filters awstypes.DescribeSourceServersRequestFilters
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &filters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mgn.DescribeSourceServersInput{
+		Filters: &filters,
+	}
+	output, err := findSourceServers(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading MGN Source Servers", err.Error())
+
+		return
+	}
+
+	// The API doesn't support filtering by tags.
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
+	filterTags := tftags.New(ctx, data.Tags)
+	sourceServers := make([]sourceServerModel, 0, len(output))
+	for _, v := range output {
+		tags := keyValueTags(ctx, v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+		if !tags.ContainsAll(filterTags) {
+			continue
+		}
+
+		sourceServer := sourceServerModel{
+			ApplicationID:           fwflex.StringToFramework(ctx, v.ApplicationID),
+			ARN:                     fwflex.StringToFramework(ctx, v.Arn),
+			Hostname:                types.StringNull(),
+			IsArchived:              fwflex.BoolToFramework(ctx, v.IsArchived),
+			LifecycleState:          fwtypes.StringEnumNull[awstypes.LifeCycleState](),
+			RecommendedInstanceType: types.StringNull(),
+			ReplicationType:         fwtypes.StringEnumValue(v.ReplicationType),
+			SourceServerID:          fwflex.StringToFramework(ctx, v.SourceServerID),
+			Tags:                    tftags.FlattenStringValueMap(ctx, tags.Map()),
+		}
+		if v := v.LifeCycle; v != nil {
+			sourceServer.LifecycleState = fwtypes.StringEnumValue(v.State)
+		}
+		if v := v.SourceProperties; v != nil {
+			sourceServer.RecommendedInstanceType = fwflex.StringToFramework(ctx, v.RecommendedInstanceType)
+			if v := v.IdentificationHints; v != nil {
+				sourceServer.Hostname = fwflex.StringToFramework(ctx, v.Hostname)
+			}
+		}
+
+		sourceServers = append(sourceServers, sourceServer)
+	}
+
+	data.SourceServers = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, sourceServers)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type sourceServersDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationIDs   fwtypes.SetOfString                                `tfsdk:"application_ids"`
+	IsArchived       types.Bool                                         `tfsdk:"is_archived"`
+	LifeCycleStates  fwtypes.SetOfStringEnum[awstypes.LifeCycleState]   `tfsdk:"lifecycle_states"`
+	ReplicationTypes fwtypes.SetOfStringEnum[awstypes.ReplicationType]  `tfsdk:"replication_types"`
+	SourceServerIDs  fwtypes.SetOfString                                `tfsdk:"source_server_ids"`
+	SourceServers    fwtypes.ListNestedObjectValueOf[sourceServerModel] `tfsdk:"source_servers" autoflex:"-"`
+	Tags             tftags.Map                                         `tfsdk:"tags"`
+}
+
+type sourceServerModel struct {
+	ApplicationID           types.String                                 `tfsdk:"application_id"`
+	ARN                     types.String                                 `tfsdk:"arn"`
+	Hostname                types.String                                 `tfsdk:"hostname"`
+	IsArchived              types.Bool                                   `tfsdk:"is_archived"`
+	LifecycleState          fwtypes.StringEnum[awstypes.LifeCycleState]  `tfsdk:"lifecycle_state"`
+	RecommendedInstanceType types.String                                 `tfsdk:"recommended_instance_type"`
+	ReplicationType         fwtypes.StringEnum[awstypes.ReplicationType] `tfsdk:"replication_type"`
+	SourceServerID          types.String                                 `tfsdk:"source_server_id"`
+	Tags                    tftags.Map                                   `tfsdk:"tags"`
+}
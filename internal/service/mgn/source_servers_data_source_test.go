@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnSourceServersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mgn_source_servers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServersDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "source_servers.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMgnSourceServersDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, "MGN_SOURCE_SERVER_ID")
+	dataSourceName := "data.aws_mgn_source_servers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServersDataSourceConfig_sourceServerIDs(sourceServerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "source_servers.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_servers.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_servers.0.lifecycle_state"),
+					resource.TestCheckResourceAttr(dataSourceName, "source_servers.0.source_server_id", sourceServerID),
+				),
+			},
+			{
+				Config: testAccSourceServersDataSourceConfig_tags(sourceServerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "source_servers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceServersDataSourceConfig_basic() string {
+	return `
+data "aws_mgn_source_servers" "test" {
+  lifecycle_states = ["READY_FOR_TEST", "READY_FOR_CUTOVER"]
+}
+`
+}
+
+func testAccSourceServersDataSourceConfig_sourceServerIDs(sourceServerID string) string {
+	return fmt.Sprintf(`
+data "aws_mgn_source_servers" "test" {
+  source_server_ids = [%[1]q]
+}
+`, sourceServerID)
+}
+
+func testAccSourceServersDataSourceConfig_tags(sourceServerID string) string {
+	return fmt.Sprintf(`
+data "aws_mgn_source_servers" "test" {
+  source_server_ids = [%[1]q]
+
+  tags = {
+    "tf-acc-test-no-such-tag" = "no-such-value"
+  }
+}
+`, sourceServerID)
+}
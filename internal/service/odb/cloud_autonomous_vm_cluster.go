@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_odb_cloud_autonomous_vm_cluster", name="Cloud Autonomous VM Cluster")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;awstypes;awstypes.CloudAutonomousVmCluster")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newCloudAutonomousVMClusterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &cloudAutonomousVMClusterResource{}
+
+	r.SetDefaultCreateTimeout(24 * time.Hour)
+	r.SetDefaultDeleteTimeout(24 * time.Hour)
+
+	return r, nil
+}
+
+type cloudAutonomousVMClusterResource struct {
+	framework.ResourceWithModel[cloudAutonomousVMClusterResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *cloudAutonomousVMClusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	maintenanceWindow := maintenanceWindowBlock(ctx)
+	maintenanceWindow.PlanModifiers = []planmodifier.List{
+		listplanmodifier.RequiresReplace(),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"autonomous_data_storage_size_in_tbs": schema.Float64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_exadata_infrastructure_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compute_model": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComputeModel](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_core_count": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_core_count_per_node": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"db_servers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"is_mtls_enabled_vm_cluster": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"license_model": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LicenseModel](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"memory_per_oracle_compute_unit_in_gbs": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"node_count": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_resource_anchor_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ocid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"odb_network_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scan_listener_port_non_tls": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"scan_listener_port_tls": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"shape": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"time_zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_container_databases": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance_window": maintenanceWindow,
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *cloudAutonomousVMClusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cloudAutonomousVMClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.DisplayName)
+	var input odb.CreateCloudAutonomousVmClusterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCloudAutonomousVmCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ODB Cloud Autonomous VM Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	clusterID := aws.ToString(output.CloudAutonomousVmClusterId)
+	data.ID = fwflex.StringValueToFramework(ctx, clusterID)
+
+	cluster, err := waitCloudAutonomousVMClusterCreated(ctx, conn, clusterID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), clusterID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud Autonomous VM Cluster (%s) create", clusterID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, cluster, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cloudAutonomousVMClusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cloudAutonomousVMClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findCloudAutonomousVMClusterByID(ctx, conn, clusterID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ODB Cloud Autonomous VM Cluster (%s)", clusterID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cloudAutonomousVMClusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+	var data cloudAutonomousVMClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cloudAutonomousVMClusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cloudAutonomousVMClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := odb.DeleteCloudAutonomousVmClusterInput{
+		CloudAutonomousVmClusterId: aws.String(clusterID),
+	}
+	_, err := conn.DeleteCloudAutonomousVmCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ODB Cloud Autonomous VM Cluster (%s)", clusterID), err.Error())
+
+		return
+	}
+
+	if _, err := waitCloudAutonomousVMClusterDeleted(ctx, conn, clusterID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud Autonomous VM Cluster (%s) delete", clusterID), err.Error())
+
+		return
+	}
+}
+
+func findCloudAutonomousVMClusterByID(ctx context.Context, conn *odb.Client, id string) (*awstypes.CloudAutonomousVmCluster, error) {
+	input := odb.GetCloudAutonomousVmClusterInput{
+		CloudAutonomousVmClusterId: aws.String(id),
+	}
+	output, err := conn.GetCloudAutonomousVmCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CloudAutonomousVmCluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.CloudAutonomousVmCluster.Status; status == awstypes.ResourceStatusTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.CloudAutonomousVmCluster, nil
+}
+
+func statusCloudAutonomousVMCluster(ctx context.Context, conn *odb.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findCloudAutonomousVMClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCloudAutonomousVMClusterCreated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudAutonomousVmCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusProvisioning),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusCloudAutonomousVMCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudAutonomousVmCluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCloudAutonomousVMClusterDeleted(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudAutonomousVmCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusTerminating),
+		Target:  []string{},
+		Refresh: statusCloudAutonomousVMCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudAutonomousVmCluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type cloudAutonomousVMClusterResourceModel struct {
+	framework.WithRegionModel
+	ARN                             types.String                                            `tfsdk:"arn" autoflex:"name=CloudAutonomousVmClusterArn"`
+	AutonomousDataStorageSizeInTBs  types.Float64                                           `tfsdk:"autonomous_data_storage_size_in_tbs"`
+	CloudExadataInfrastructureID    types.String                                            `tfsdk:"cloud_exadata_infrastructure_id"`
+	ComputeModel                    fwtypes.StringEnum[awstypes.ComputeModel]               `tfsdk:"compute_model"`
+	CPUCoreCount                    types.Int32                                             `tfsdk:"cpu_core_count"`
+	CPUCoreCountPerNode             types.Int32                                             `tfsdk:"cpu_core_count_per_node"`
+	CreatedAt                       timetypes.RFC3339                                       `tfsdk:"created_at"`
+	DBServers                       fwtypes.SetOfString                                     `tfsdk:"db_servers"`
+	Description                     types.String                                            `tfsdk:"description"`
+	DisplayName                     types.String                                            `tfsdk:"display_name"`
+	Domain                          types.String                                            `tfsdk:"domain"`
+	Hostname                        types.String                                            `tfsdk:"hostname"`
+	ID                              types.String                                            `tfsdk:"id" autoflex:"name=CloudAutonomousVmClusterId"`
+	IsMTLSEnabledVMCluster          types.Bool                                              `tfsdk:"is_mtls_enabled_vm_cluster"`
+	LicenseModel                    fwtypes.StringEnum[awstypes.LicenseModel]               `tfsdk:"license_model"`
+	MaintenanceWindow               fwtypes.ListNestedObjectValueOf[maintenanceWindowModel] `tfsdk:"maintenance_window"`
+	MemoryPerOracleComputeUnitInGBs types.Int32                                             `tfsdk:"memory_per_oracle_compute_unit_in_gbs"`
+	NodeCount                       types.Int32                                             `tfsdk:"node_count"`
+	OCIResourceAnchorName           types.String                                            `tfsdk:"oci_resource_anchor_name"`
+	OCIURL                          types.String                                            `tfsdk:"oci_url"`
+	OCID                            types.String                                            `tfsdk:"ocid"`
+	ODBNetworkID                    types.String                                            `tfsdk:"odb_network_id"`
+	ScanListenerPortNonTLS          types.Int32                                             `tfsdk:"scan_listener_port_non_tls"`
+	ScanListenerPortTLS             types.Int32                                             `tfsdk:"scan_listener_port_tls"`
+	Shape                           types.String                                            `tfsdk:"shape"`
+	Tags                            tftags.Map                                              `tfsdk:"tags"`
+	TagsAll                         tftags.Map                                              `tfsdk:"tags_all"`
+	Timeouts                        timeouts.Value                                          `tfsdk:"timeouts"`
+	TimeZone                        types.String                                            `tfsdk:"time_zone"`
+	TotalContainerDatabases         types.Int32                                             `tfsdk:"total_container_databases"`
+}
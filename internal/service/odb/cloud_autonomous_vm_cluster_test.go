@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfodb "github.com/hashicorp/terraform-provider-aws/internal/service/odb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccODBCloudAutonomousVMCluster_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudAutonomousVmCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_autonomous_vm_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudAutonomousVMClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudAutonomousVMClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudAutonomousVMClusterExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "odb", regexache.MustCompile(`cloud-autonomous-vm-cluster/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "autonomous_data_storage_size_in_tbs", "5"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloud_exadata_infrastructure_id", "aws_odb_cloud_exadata_infrastructure.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "cpu_core_count_per_node", "40"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "memory_per_oracle_compute_unit_in_gbs", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "ocid"),
+					resource.TestCheckResourceAttrPair(resourceName, "odb_network_id", "aws_odb_network.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "total_container_databases", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccODBCloudAutonomousVMCluster_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudAutonomousVmCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_autonomous_vm_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudAutonomousVMClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudAutonomousVMClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudAutonomousVMClusterExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfodb.ResourceCloudAutonomousVMCluster, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudAutonomousVMClusterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_odb_cloud_autonomous_vm_cluster" {
+				continue
+			}
+
+			_, err := tfodb.FindCloudAutonomousVMClusterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ODB Cloud Autonomous VM Cluster %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudAutonomousVMClusterExists(ctx context.Context, n string, v *awstypes.CloudAutonomousVmCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		output, err := tfodb.FindCloudAutonomousVMClusterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCloudAutonomousVMClusterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCloudVMClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_odb_cloud_autonomous_vm_cluster" "test" {
+  display_name                          = %[1]q
+  cloud_exadata_infrastructure_id       = aws_odb_cloud_exadata_infrastructure.test.id
+  odb_network_id                        = aws_odb_network.test.id
+  autonomous_data_storage_size_in_tbs   = 5
+  cpu_core_count_per_node               = 40
+  memory_per_oracle_compute_unit_in_gbs = 2
+  total_container_databases             = 1
+  db_servers                            = data.aws_odb_db_servers.test.db_servers[*].id
+
+  maintenance_window {
+    preference = "NO_PREFERENCE"
+  }
+}
+`, rName))
+}
@@ -0,0 +1,618 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_odb_cloud_exadata_infrastructure", name="Cloud Exadata Infrastructure")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;awstypes;awstypes.CloudExadataInfrastructure")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newCloudExadataInfrastructureResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &cloudExadataInfrastructureResource{}
+
+	r.SetDefaultCreateTimeout(24 * time.Hour)
+	r.SetDefaultUpdateTimeout(24 * time.Hour)
+	r.SetDefaultDeleteTimeout(24 * time.Hour)
+
+	return r, nil
+}
+
+type cloudExadataInfrastructureResource struct {
+	framework.ResourceWithModel[cloudExadataInfrastructureResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *cloudExadataInfrastructureResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	computedInt32 := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Computed: true,
+		}
+	}
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"activated_storage_count":  computedInt32(),
+			"additional_storage_count": computedInt32(),
+			names.AttrARN:              framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("availability_zone_id")),
+				},
+			},
+			"availability_zone_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"available_storage_size_in_gbs": computedInt32(),
+			"compute_count": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(2),
+				},
+			},
+			"compute_model": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComputeModel](),
+				Computed:   true,
+			},
+			"cpu_count": computedInt32(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_contacts_to_send_to_oci": schema.SetNestedAttribute{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[customerContactModel](ctx),
+				Optional:   true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEmail: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"data_storage_size_in_tbs": schema.Float64Attribute{
+				Computed: true,
+			},
+			"database_server_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"db_node_storage_size_in_gbs": computedInt32(),
+			"db_server_version":           computedString(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID:                      framework.IDAttribute(),
+			"last_maintenance_run_id":         computedString(),
+			"max_cpu_count":                   computedInt32(),
+			"max_data_storage_in_tbs":         schema.Float64Attribute{Computed: true},
+			"max_db_node_storage_size_in_gbs": computedInt32(),
+			"max_memory_in_gbs":               computedInt32(),
+			"memory_size_in_gbs":              computedInt32(),
+			"monthly_db_server_version":       computedString(),
+			"monthly_storage_server_version":  computedString(),
+			"next_maintenance_run_id":         computedString(),
+			"oci_resource_anchor_name":        computedString(),
+			"oci_url":                         computedString(),
+			"ocid":                            computedString(),
+			"shape": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_count": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(3),
+				},
+			},
+			"storage_server_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage_server_version":    computedString(),
+			names.AttrTags:              tftags.TagsAttribute(),
+			names.AttrTagsAll:           tftags.TagsAttributeComputedOnly(),
+			"total_storage_size_in_gbs": computedInt32(),
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance_window": maintenanceWindowBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func maintenanceWindowBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[maintenanceWindowModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"custom_action_timeout_in_mins": schema.Int32Attribute{
+					Optional: true,
+					Computed: true,
+					Validators: []validator.Int32{
+						int32validator.Between(15, 120),
+					},
+				},
+				"days_of_week": schema.SetNestedAttribute{
+					CustomType: fwtypes.NewSetNestedObjectTypeOf[dayOfWeekModel](ctx),
+					Optional:   true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrName: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.DayOfWeekName](),
+								Required:   true,
+							},
+						},
+					},
+				},
+				"hours_of_day": schema.SetAttribute{
+					CustomType:  fwtypes.NewSetTypeOf[types.Int64](ctx),
+					ElementType: types.Int64Type,
+					Optional:    true,
+				},
+				"is_custom_action_timeout_enabled": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"lead_time_in_weeks": schema.Int32Attribute{
+					Optional: true,
+					Validators: []validator.Int32{
+						int32validator.Between(1, 4),
+					},
+				},
+				"months": schema.SetNestedAttribute{
+					CustomType: fwtypes.NewSetNestedObjectTypeOf[monthModel](ctx),
+					Optional:   true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrName: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MonthName](),
+								Required:   true,
+							},
+						},
+					},
+				},
+				"patching_mode": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PatchingModeType](),
+					Optional:   true,
+					Computed:   true,
+				},
+				"preference": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PreferenceType](),
+					Required:   true,
+				},
+				"skip_ru": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"weeks_of_month": schema.SetAttribute{
+					CustomType:  fwtypes.NewSetTypeOf[types.Int64](ctx),
+					ElementType: types.Int64Type,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudExadataInfrastructureResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cloudExadataInfrastructureResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.DisplayName)
+	var input odb.CreateCloudExadataInfrastructureInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCloudExadataInfrastructure(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ODB Cloud Exadata Infrastructure (%s)", name), err.Error())
+
+		return
+	}
+
+	infrastructureID := aws.ToString(output.CloudExadataInfrastructureId)
+	data.ID = fwflex.StringValueToFramework(ctx, infrastructureID)
+
+	infrastructure, err := waitCloudExadataInfrastructureCreated(ctx, conn, infrastructureID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), infrastructureID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud Exadata Infrastructure (%s) create", infrastructureID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, infrastructure, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cloudExadataInfrastructureResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cloudExadataInfrastructureResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	infrastructureID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findCloudExadataInfrastructureByID(ctx, conn, infrastructureID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ODB Cloud Exadata Infrastructure (%s)", infrastructureID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cloudExadataInfrastructureResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old cloudExadataInfrastructureResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	infrastructureID := fwflex.StringValueFromFramework(ctx, new.ID)
+
+	if !new.MaintenanceWindow.Equal(old.MaintenanceWindow) {
+		input := odb.UpdateCloudExadataInfrastructureInput{
+			CloudExadataInfrastructureId: aws.String(infrastructureID),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.MaintenanceWindow, &input.MaintenanceWindow)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCloudExadataInfrastructure(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ODB Cloud Exadata Infrastructure (%s)", infrastructureID), err.Error())
+
+			return
+		}
+
+		infrastructure, err := waitCloudExadataInfrastructureUpdated(ctx, conn, infrastructureID, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud Exadata Infrastructure (%s) update", infrastructureID), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, infrastructure, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *cloudExadataInfrastructureResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cloudExadataInfrastructureResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	infrastructureID := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := odb.DeleteCloudExadataInfrastructureInput{
+		CloudExadataInfrastructureId: aws.String(infrastructureID),
+	}
+	_, err := conn.DeleteCloudExadataInfrastructure(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ODB Cloud Exadata Infrastructure (%s)", infrastructureID), err.Error())
+
+		return
+	}
+
+	if _, err := waitCloudExadataInfrastructureDeleted(ctx, conn, infrastructureID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud Exadata Infrastructure (%s) delete", infrastructureID), err.Error())
+
+		return
+	}
+}
+
+func findCloudExadataInfrastructureByID(ctx context.Context, conn *odb.Client, id string) (*awstypes.CloudExadataInfrastructure, error) {
+	input := odb.GetCloudExadataInfrastructureInput{
+		CloudExadataInfrastructureId: aws.String(id),
+	}
+	output, err := conn.GetCloudExadataInfrastructure(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CloudExadataInfrastructure == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.CloudExadataInfrastructure.Status; status == awstypes.ResourceStatusTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.CloudExadataInfrastructure, nil
+}
+
+func statusCloudExadataInfrastructure(ctx context.Context, conn *odb.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findCloudExadataInfrastructureByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCloudExadataInfrastructureCreated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudExadataInfrastructure, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusProvisioning),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusCloudExadataInfrastructure(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudExadataInfrastructure); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCloudExadataInfrastructureUpdated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudExadataInfrastructure, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusUpdating),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusCloudExadataInfrastructure(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudExadataInfrastructure); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCloudExadataInfrastructureDeleted(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudExadataInfrastructure, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusTerminating),
+		Target:  []string{},
+		Refresh: statusCloudExadataInfrastructure(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudExadataInfrastructure); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type cloudExadataInfrastructureResourceModel struct {
+	framework.WithRegionModel
+	ActivatedStorageCount       types.Int32                                             `tfsdk:"activated_storage_count"`
+	AdditionalStorageCount      types.Int32                                             `tfsdk:"additional_storage_count"`
+	ARN                         types.String                                            `tfsdk:"arn" autoflex:"name=CloudExadataInfrastructureArn"`
+	AvailabilityZone            types.String                                            `tfsdk:"availability_zone"`
+	AvailabilityZoneID          types.String                                            `tfsdk:"availability_zone_id"`
+	AvailableStorageSizeInGBs   types.Int32                                             `tfsdk:"available_storage_size_in_gbs"`
+	ComputeCount                types.Int32                                             `tfsdk:"compute_count"`
+	ComputeModel                fwtypes.StringEnum[awstypes.ComputeModel]               `tfsdk:"compute_model"`
+	CPUCount                    types.Int32                                             `tfsdk:"cpu_count"`
+	CreatedAt                   timetypes.RFC3339                                       `tfsdk:"created_at"`
+	CustomerContactsToSendToOCI fwtypes.SetNestedObjectValueOf[customerContactModel]    `tfsdk:"customer_contacts_to_send_to_oci"`
+	DatabaseServerType          types.String                                            `tfsdk:"database_server_type"`
+	DataStorageSizeInTBs        types.Float64                                           `tfsdk:"data_storage_size_in_tbs"`
+	DBNodeStorageSizeInGBs      types.Int32                                             `tfsdk:"db_node_storage_size_in_gbs"`
+	DBServerVersion             types.String                                            `tfsdk:"db_server_version"`
+	DisplayName                 types.String                                            `tfsdk:"display_name"`
+	ID                          types.String                                            `tfsdk:"id" autoflex:"name=CloudExadataInfrastructureId"`
+	LastMaintenanceRunID        types.String                                            `tfsdk:"last_maintenance_run_id"`
+	MaintenanceWindow           fwtypes.ListNestedObjectValueOf[maintenanceWindowModel] `tfsdk:"maintenance_window"`
+	MaxCPUCount                 types.Int32                                             `tfsdk:"max_cpu_count"`
+	MaxDataStorageInTBs         types.Float64                                           `tfsdk:"max_data_storage_in_tbs"`
+	MaxDBNodeStorageSizeInGBs   types.Int32                                             `tfsdk:"max_db_node_storage_size_in_gbs"`
+	MaxMemoryInGBs              types.Int32                                             `tfsdk:"max_memory_in_gbs"`
+	MemorySizeInGBs             types.Int32                                             `tfsdk:"memory_size_in_gbs"`
+	MonthlyDBServerVersion      types.String                                            `tfsdk:"monthly_db_server_version"`
+	MonthlyStorageServerVersion types.String                                            `tfsdk:"monthly_storage_server_version"`
+	NextMaintenanceRunID        types.String                                            `tfsdk:"next_maintenance_run_id"`
+	OCIResourceAnchorName       types.String                                            `tfsdk:"oci_resource_anchor_name"`
+	OCIURL                      types.String                                            `tfsdk:"oci_url"`
+	OCID                        types.String                                            `tfsdk:"ocid"`
+	Shape                       types.String                                            `tfsdk:"shape"`
+	StorageCount                types.Int32                                             `tfsdk:"storage_count"`
+	StorageServerType           types.String                                            `tfsdk:"storage_server_type"`
+	StorageServerVersion        types.String                                            `tfsdk:"storage_server_version"`
+	Tags                        tftags.Map                                              `tfsdk:"tags"`
+	TagsAll                     tftags.Map                                              `tfsdk:"tags_all"`
+	Timeouts                    timeouts.Value                                          `tfsdk:"timeouts"`
+	TotalStorageSizeInGBs       types.Int32                                             `tfsdk:"total_storage_size_in_gbs"`
+}
+
+type customerContactModel struct {
+	Email types.String `tfsdk:"email"`
+}
+
+type maintenanceWindowModel struct {
+	CustomActionTimeoutInMins    types.Int32                                    `tfsdk:"custom_action_timeout_in_mins"`
+	DaysOfWeek                   fwtypes.SetNestedObjectValueOf[dayOfWeekModel] `tfsdk:"days_of_week"`
+	HoursOfDay                   fwtypes.SetValueOf[types.Int64]                `tfsdk:"hours_of_day"`
+	IsCustomActionTimeoutEnabled types.Bool                                     `tfsdk:"is_custom_action_timeout_enabled"`
+	LeadTimeInWeeks              types.Int32                                    `tfsdk:"lead_time_in_weeks"`
+	Months                       fwtypes.SetNestedObjectValueOf[monthModel]     `tfsdk:"months"`
+	PatchingMode                 fwtypes.StringEnum[awstypes.PatchingModeType]  `tfsdk:"patching_mode"`
+	Preference                   fwtypes.StringEnum[awstypes.PreferenceType]    `tfsdk:"preference"`
+	SkipRU                       types.Bool                                     `tfsdk:"skip_ru"`
+	WeeksOfMonth                 fwtypes.SetValueOf[types.Int64]                `tfsdk:"weeks_of_month"`
+}
+
+type dayOfWeekModel struct {
+	Name fwtypes.StringEnum[awstypes.DayOfWeekName] `tfsdk:"name"`
+}
+
+type monthModel struct {
+	Name fwtypes.StringEnum[awstypes.MonthName] `tfsdk:"name"`
+}
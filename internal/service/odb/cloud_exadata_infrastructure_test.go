@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfodb "github.com/hashicorp/terraform-provider-aws/internal/service/odb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccODBCloudExadataInfrastructure_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudExadataInfrastructure
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_exadata_infrastructure.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudExadataInfrastructureConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "odb", regexache.MustCompile(`cloud-exadata-infrastructure/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.preference", "NO_PREFERENCE"),
+					resource.TestCheckResourceAttrSet(resourceName, "ocid"),
+					resource.TestCheckResourceAttr(resourceName, "shape", "Exadata.X9M"),
+					resource.TestCheckResourceAttr(resourceName, "storage_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccODBCloudExadataInfrastructure_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudExadataInfrastructure
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_exadata_infrastructure.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudExadataInfrastructureConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfodb.ResourceCloudExadataInfrastructure, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccODBCloudExadataInfrastructure_maintenanceWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudExadataInfrastructure
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_exadata_infrastructure.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudExadataInfrastructureConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.preference", "NO_PREFERENCE"),
+				),
+			},
+			{
+				Config: testAccCloudExadataInfrastructureConfig_maintenanceWindow(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudExadataInfrastructureExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.days_of_week.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "maintenance_window.0.days_of_week.*", map[string]string{
+						names.AttrName: "MONDAY",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.hours_of_day.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "maintenance_window.0.hours_of_day.*", "4"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "maintenance_window.0.hours_of_day.*", "16"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.lead_time_in_weeks", "2"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.months.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.patching_mode", "ROLLING"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.preference", "CUSTOM_PREFERENCE"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.weeks_of_month.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudExadataInfrastructureDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_odb_cloud_exadata_infrastructure" {
+				continue
+			}
+
+			_, err := tfodb.FindCloudExadataInfrastructureByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ODB Cloud Exadata Infrastructure %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudExadataInfrastructureExists(ctx context.Context, n string, v *awstypes.CloudExadataInfrastructure) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		output, err := tfodb.FindCloudExadataInfrastructureByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCloudExadataInfrastructureConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkConfig_base(), fmt.Sprintf(`
+resource "aws_odb_cloud_exadata_infrastructure" "test" {
+  display_name         = %[1]q
+  shape                = "Exadata.X9M"
+  compute_count        = 2
+  storage_count        = 3
+  availability_zone_id = data.aws_availability_zones.available.zone_ids[0]
+
+  maintenance_window {
+    preference = "NO_PREFERENCE"
+  }
+}
+`, rName))
+}
+
+func testAccCloudExadataInfrastructureConfig_maintenanceWindow(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkConfig_base(), fmt.Sprintf(`
+resource "aws_odb_cloud_exadata_infrastructure" "test" {
+  display_name         = %[1]q
+  shape                = "Exadata.X9M"
+  compute_count        = 2
+  storage_count        = 3
+  availability_zone_id = data.aws_availability_zones.available.zone_ids[0]
+
+  maintenance_window {
+    preference         = "CUSTOM_PREFERENCE"
+    patching_mode      = "ROLLING"
+    lead_time_in_weeks = 2
+    hours_of_day       = [4, 16]
+    weeks_of_month     = [2]
+
+    days_of_week = [{
+      name = "MONDAY"
+    }]
+
+    months = [{
+      name = "FEBRUARY"
+      }, {
+      name = "MAY"
+      }, {
+      name = "AUGUST"
+      }, {
+      name = "NOVEMBER"
+    }]
+  }
+}
+`, rName))
+}
@@ -0,0 +1,604 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_odb_cloud_vm_cluster", name="Cloud VM Cluster")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;awstypes;awstypes.CloudVmCluster")
+// @Testing(importIgnore="gi_version;hostname_prefix")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newCloudVMClusterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &cloudVMClusterResource{}
+
+	r.SetDefaultCreateTimeout(24 * time.Hour)
+	r.SetDefaultDeleteTimeout(24 * time.Hour)
+
+	return r, nil
+}
+
+type cloudVMClusterResource struct {
+	framework.ResourceWithModel[cloudVMClusterResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *cloudVMClusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cloud_exadata_infrastructure_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrClusterName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compute_model": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComputeModel](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_core_count": schema.Int32Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_storage_size_in_tbs": schema.Float64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"db_node_storage_size_in_gbs": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"db_servers": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk_redundancy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DiskRedundancy](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gi_version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname_prefix": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"is_local_backup_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_sparse_diskgroup_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"license_model": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LicenseModel](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"memory_size_in_gbs": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_count": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_resource_anchor_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ocid": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"odb_network_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scan_dns_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scan_ip_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scan_listener_port_tcp": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"shape": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_public_keys": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"storage_size_in_gbs": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"time_zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vip_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_collection_options": dataCollectionOptionsBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func dataCollectionOptionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dataCollectionOptionsModel](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"is_diagnostics_events_enabled": schema.BoolAttribute{
+					Required: true,
+				},
+				"is_health_monitoring_enabled": schema.BoolAttribute{
+					Required: true,
+				},
+				"is_incident_logs_enabled": schema.BoolAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudVMClusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cloudVMClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.DisplayName)
+	var input odb.CreateCloudVmClusterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.GiVersion = fwflex.StringFromFramework(ctx, data.GIVersion)
+	input.Hostname = fwflex.StringFromFramework(ctx, data.HostnamePrefix)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCloudVmCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ODB Cloud VM Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	clusterID := aws.ToString(output.CloudVmClusterId)
+	data.ID = fwflex.StringValueToFramework(ctx, clusterID)
+
+	cluster, err := waitCloudVMClusterCreated(ctx, conn, clusterID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), clusterID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud VM Cluster (%s) create", clusterID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, cluster, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ScanListenerPortTCP = fwflex.Int32ToFramework(ctx, cluster.ListenerPort)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cloudVMClusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cloudVMClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findCloudVMClusterByID(ctx, conn, clusterID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ODB Cloud VM Cluster (%s)", clusterID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// The API returns the full Grid Infrastructure version, not the configured major version.
+	if data.GIVersion.IsNull() {
+		data.GIVersion = fwflex.StringToFramework(ctx, output.GiVersion)
+	}
+	data.ScanListenerPortTCP = fwflex.Int32ToFramework(ctx, output.ListenerPort)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cloudVMClusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+	var data cloudVMClusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cloudVMClusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cloudVMClusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := odb.DeleteCloudVmClusterInput{
+		CloudVmClusterId: aws.String(clusterID),
+	}
+	_, err := conn.DeleteCloudVmCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ODB Cloud VM Cluster (%s)", clusterID), err.Error())
+
+		return
+	}
+
+	if _, err := waitCloudVMClusterDeleted(ctx, conn, clusterID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Cloud VM Cluster (%s) delete", clusterID), err.Error())
+
+		return
+	}
+}
+
+func findCloudVMClusterByID(ctx context.Context, conn *odb.Client, id string) (*awstypes.CloudVmCluster, error) {
+	input := odb.GetCloudVmClusterInput{
+		CloudVmClusterId: aws.String(id),
+	}
+	output, err := conn.GetCloudVmCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CloudVmCluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.CloudVmCluster.Status; status == awstypes.ResourceStatusTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.CloudVmCluster, nil
+}
+
+func statusCloudVMCluster(ctx context.Context, conn *odb.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findCloudVMClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCloudVMClusterCreated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudVmCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusProvisioning),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusCloudVMCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudVmCluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCloudVMClusterDeleted(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.CloudVmCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusTerminating),
+		Target:  []string{},
+		Refresh: statusCloudVMCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CloudVmCluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type cloudVMClusterResourceModel struct {
+	framework.WithRegionModel
+	ARN                          types.String                                                `tfsdk:"arn" autoflex:"name=CloudVmClusterArn"`
+	CloudExadataInfrastructureID types.String                                                `tfsdk:"cloud_exadata_infrastructure_id"`
+	ClusterName                  types.String                                                `tfsdk:"cluster_name"`
+	ComputeModel                 fwtypes.StringEnum[awstypes.ComputeModel]                   `tfsdk:"compute_model"`
+	CPUCoreCount                 types.Int32                                                 `tfsdk:"cpu_core_count"`
+	CreatedAt                    timetypes.RFC3339                                           `tfsdk:"created_at"`
+	DataCollectionOptions        fwtypes.ListNestedObjectValueOf[dataCollectionOptionsModel] `tfsdk:"data_collection_options"`
+	DataStorageSizeInTBs         types.Float64                                               `tfsdk:"data_storage_size_in_tbs"`
+	DBNodeStorageSizeInGBs       types.Int32                                                 `tfsdk:"db_node_storage_size_in_gbs"`
+	DBServers                    fwtypes.SetOfString                                         `tfsdk:"db_servers"`
+	DiskRedundancy               fwtypes.StringEnum[awstypes.DiskRedundancy]                 `tfsdk:"disk_redundancy"`
+	DisplayName                  types.String                                                `tfsdk:"display_name"`
+	Domain                       types.String                                                `tfsdk:"domain"`
+	GIVersion                    types.String                                                `tfsdk:"gi_version" autoflex:"-"`
+	Hostname                     types.String                                                `tfsdk:"hostname"`
+	HostnamePrefix               types.String                                                `tfsdk:"hostname_prefix" autoflex:"-"`
+	ID                           types.String                                                `tfsdk:"id" autoflex:"name=CloudVmClusterId"`
+	IsLocalBackupEnabled         types.Bool                                                  `tfsdk:"is_local_backup_enabled"`
+	IsSparseDiskgroupEnabled     types.Bool                                                  `tfsdk:"is_sparse_diskgroup_enabled"`
+	LicenseModel                 fwtypes.StringEnum[awstypes.LicenseModel]                   `tfsdk:"license_model"`
+	MemorySizeInGBs              types.Int32                                                 `tfsdk:"memory_size_in_gbs"`
+	NodeCount                    types.Int32                                                 `tfsdk:"node_count"`
+	OCIResourceAnchorName        types.String                                                `tfsdk:"oci_resource_anchor_name"`
+	OCIURL                       types.String                                                `tfsdk:"oci_url"`
+	OCID                         types.String                                                `tfsdk:"ocid"`
+	ODBNetworkID                 types.String                                                `tfsdk:"odb_network_id"`
+	ScanDNSName                  types.String                                                `tfsdk:"scan_dns_name"`
+	ScanIPIDs                    fwtypes.ListOfString                                        `tfsdk:"scan_ip_ids"`
+	ScanListenerPortTCP          types.Int32                                                 `tfsdk:"scan_listener_port_tcp"`
+	Shape                        types.String                                                `tfsdk:"shape"`
+	SSHPublicKeys                fwtypes.SetOfString                                         `tfsdk:"ssh_public_keys"`
+	StorageSizeInGBs             types.Int32                                                 `tfsdk:"storage_size_in_gbs"`
+	SystemVersion                types.String                                                `tfsdk:"system_version"`
+	Tags                         tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                                  `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value                                              `tfsdk:"timeouts"`
+	TimeZone                     types.String                                                `tfsdk:"time_zone"`
+	VIPIDs                       fwtypes.ListOfString                                        `tfsdk:"vip_ids"`
+}
+
+type dataCollectionOptionsModel struct {
+	IsDiagnosticsEventsEnabled types.Bool `tfsdk:"is_diagnostics_events_enabled"`
+	IsHealthMonitoringEnabled  types.Bool `tfsdk:"is_health_monitoring_enabled"`
+	IsIncidentLogsEnabled      types.Bool `tfsdk:"is_incident_logs_enabled"`
+}
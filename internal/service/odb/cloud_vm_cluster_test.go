@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfodb "github.com/hashicorp/terraform-provider-aws/internal/service/odb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccODBCloudVMCluster_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudVmCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_vm_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudVMClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudVMClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudVMClusterExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "odb", regexache.MustCompile(`cloud-vm-cluster/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "cloud_exadata_infrastructure_id", "aws_odb_cloud_exadata_infrastructure.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "cpu_core_count", "16"),
+					resource.TestCheckResourceAttr(resourceName, "data_collection_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, "gi_version", "23.0.0.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostname"),
+					resource.TestCheckResourceAttr(resourceName, "hostname_prefix", "apollo"),
+					resource.TestCheckResourceAttrSet(resourceName, "ocid"),
+					resource.TestCheckResourceAttrPair(resourceName, "odb_network_id", "aws_odb_network.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ssh_public_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"gi_version", "hostname_prefix"},
+			},
+		},
+	})
+}
+
+func TestAccODBCloudVMCluster_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CloudVmCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_odb_cloud_vm_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudVMClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudVMClusterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudVMClusterExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfodb.ResourceCloudVMCluster, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudVMClusterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_odb_cloud_vm_cluster" {
+				continue
+			}
+
+			_, err := tfodb.FindCloudVMClusterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ODB Cloud VM Cluster %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudVMClusterExists(ctx context.Context, n string, v *awstypes.CloudVmCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ODBClient(ctx)
+
+		output, err := tfodb.FindCloudVMClusterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCloudVMClusterConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkConfig_basic(rName), fmt.Sprintf(`
+resource "aws_odb_cloud_exadata_infrastructure" "test" {
+  display_name         = %[1]q
+  shape                = "Exadata.X9M"
+  compute_count        = 2
+  storage_count        = 3
+  availability_zone_id = data.aws_availability_zones.available.zone_ids[0]
+
+  maintenance_window {
+    preference = "NO_PREFERENCE"
+  }
+}
+
+data "aws_odb_db_servers" "test" {
+  cloud_exadata_infrastructure_id = aws_odb_cloud_exadata_infrastructure.test.id
+}
+`, rName))
+}
+
+func testAccCloudVMClusterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCloudVMClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_odb_cloud_vm_cluster" "test" {
+  display_name                    = %[1]q
+  cloud_exadata_infrastructure_id = aws_odb_cloud_exadata_infrastructure.test.id
+  odb_network_id                  = aws_odb_network.test.id
+  cpu_core_count                  = 16
+  gi_version                      = "23.0.0.0"
+  hostname_prefix                 = "apollo"
+  ssh_public_keys                 = [%[2]q]
+  db_servers                      = data.aws_odb_db_servers.test.db_servers[*].id
+
+  data_collection_options {
+    is_diagnostics_events_enabled = false
+    is_health_monitoring_enabled  = false
+    is_incident_logs_enabled      = false
+  }
+}
+`, rName, testAccSSHPublicKey))
+}
+
+const testAccSSHPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDNt3kA/dBkS6ZyU/sVDiGMuWJQaRPmLNbs/25K/e/fIl07ZWUgqqsFkcycLLMNFGD30Cmgp6XCXfNlIjzFWhNam+4cBb4DPpvieUw44VgsHK5JQy3JKlUfglmH5rs4G5pLiVfZpFU6jqvTsu4mE1CHCP0sXJlJhGxMG3QbsqYWNKiqGFEhuzGMs6fQlMkNiXsFoDmh33HAcXCbaFSC7V7xIqT1hlKu0iOL+GNjMj4R3xy0o3jafhO4MG2s3TwCQQCyaa5oyjL8iP8p3L9yp6cbIcXaS72SIgbCSGCyrcQPIKP2lJJHvE1oVWzLVBhR4eSzrlFDv7K4IErzaJmHqdiz"
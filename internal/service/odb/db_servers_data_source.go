@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_odb_db_servers", name="DB Servers")
+func newDBServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dbServersDataSource{}, nil
+}
+
+type dbServersDataSource struct {
+	framework.DataSourceWithModel[dbServersDataSourceModel]
+}
+
+func (d *dbServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cloud_exadata_infrastructure_id": schema.StringAttribute{
+				Required: true,
+			},
+			"db_servers": framework.DataSourceComputedListOfObjectAttribute[dbServerSummaryModel](ctx),
+		},
+	}
+}
+
+func (d *dbServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dbServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ODBClient(ctx)
+
+	infrastructureID := fwflex.StringValueFromFramework(ctx, data.CloudExadataInfrastructureID)
+	input := odb.ListDbServersInput{
+		CloudExadataInfrastructureId: aws.String(infrastructureID),
+	}
+	output, err := findDBServers(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ODB Cloud Exadata Infrastructure (%s) DB Servers", infrastructureID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.DBServers)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDBServers(ctx context.Context, conn *odb.Client, input *odb.ListDbServersInput) ([]awstypes.DbServerSummary, error) {
+	var output []awstypes.DbServerSummary
+
+	pages := odb.NewListDbServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DbServers...)
+	}
+
+	return output, nil
+}
+
+type dbServersDataSourceModel struct {
+	framework.WithRegionModel
+	CloudExadataInfrastructureID types.String                                          `tfsdk:"cloud_exadata_infrastructure_id"`
+	DBServers                    fwtypes.ListNestedObjectValueOf[dbServerSummaryModel] `tfsdk:"db_servers"`
+}
+
+type dbServerSummaryModel struct {
+	AutonomousVirtualMachineIDs fwtypes.ListOfString                        `tfsdk:"autonomous_virtual_machine_ids"`
+	AutonomousVMClusterIDs      fwtypes.ListOfString                        `tfsdk:"autonomous_vm_cluster_ids"`
+	ComputeModel                fwtypes.StringEnum[awstypes.ComputeModel]   `tfsdk:"compute_model"`
+	CPUCoreCount                types.Int32                                 `tfsdk:"cpu_core_count"`
+	CreatedAt                   timetypes.RFC3339                           `tfsdk:"created_at"`
+	DBNodeStorageSizeInGBs      types.Int32                                 `tfsdk:"db_node_storage_size_in_gbs"`
+	DisplayName                 types.String                                `tfsdk:"display_name"`
+	ExadataInfrastructureID     types.String                                `tfsdk:"exadata_infrastructure_id"`
+	ID                          types.String                                `tfsdk:"id" autoflex:"name=DbServerId"`
+	MaxCPUCount                 types.Int32                                 `tfsdk:"max_cpu_count"`
+	MaxDBNodeStorageInGBs       types.Int32                                 `tfsdk:"max_db_node_storage_in_gbs"`
+	MaxMemoryInGBs              types.Int32                                 `tfsdk:"max_memory_in_gbs"`
+	MemorySizeInGBs             types.Int32                                 `tfsdk:"memory_size_in_gbs"`
+	OCIResourceAnchorName       types.String                                `tfsdk:"oci_resource_anchor_name"`
+	OCID                        types.String                                `tfsdk:"ocid"`
+	Shape                       types.String                                `tfsdk:"shape"`
+	Status                      fwtypes.StringEnum[awstypes.ResourceStatus] `tfsdk:"status"`
+	StatusReason                types.String                                `tfsdk:"status_reason"`
+	VMClusterIDs                fwtypes.ListOfString                        `tfsdk:"vm_cluster_ids"`
+}
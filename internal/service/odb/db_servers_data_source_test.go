@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccODBDBServersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_odb_db_servers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudExadataInfrastructureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBServersDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "db_servers.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_servers.0.exadata_infrastructure_id", "aws_odb_cloud_exadata_infrastructure.test", "ocid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "db_servers.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBServersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCloudExadataInfrastructureConfig_basic(rName), `
+data "aws_odb_db_servers" "test" {
+  cloud_exadata_infrastructure_id = aws_odb_cloud_exadata_infrastructure.test.id
+}
+`)
+}
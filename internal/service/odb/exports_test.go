@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+// Exports for use in tests only.
+var (
+	ResourceCloudAutonomousVMCluster   = newCloudAutonomousVMClusterResource
+	ResourceCloudExadataInfrastructure = newCloudExadataInfrastructureResource
+	ResourceCloudVMCluster             = newCloudVMClusterResource
+	ResourceNetwork                    = newNetworkResource
+	ResourceNetworkPeeringConnection   = newNetworkPeeringConnectionResource
+
+	FindCloudAutonomousVMClusterByID   = findCloudAutonomousVMClusterByID
+	FindCloudExadataInfrastructureByID = findCloudExadataInfrastructureByID
+	FindCloudVMClusterByID             = findCloudVMClusterByID
+	FindNetworkByID                    = findNetworkByID
+	FindNetworkPeeringConnectionByID   = findNetworkPeeringConnectionByID
+)
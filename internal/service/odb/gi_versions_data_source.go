@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_odb_gi_versions", name="GI Versions")
+func newGIVersionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &giVersionsDataSource{}, nil
+}
+
+type giVersionsDataSource struct {
+	framework.DataSourceWithModel[giVersionsDataSourceModel]
+}
+
+func (d *giVersionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gi_versions": framework.DataSourceComputedListOfObjectAttribute[giVersionSummaryModel](ctx),
+			"shape": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *giVersionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data giVersionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ODBClient(ctx)
+
+	var input odb.ListGiVersionsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findGIVersions(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ODB GI Versions", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.GIVersions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findGIVersions(ctx context.Context, conn *odb.Client, input *odb.ListGiVersionsInput) ([]awstypes.GiVersionSummary, error) {
+	var output []awstypes.GiVersionSummary
+
+	pages := odb.NewListGiVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.GiVersions...)
+	}
+
+	return output, nil
+}
+
+type giVersionsDataSourceModel struct {
+	framework.WithRegionModel
+	GIVersions fwtypes.ListNestedObjectValueOf[giVersionSummaryModel] `tfsdk:"gi_versions" autoflex:"-"`
+	Shape      types.String                                           `tfsdk:"shape"`
+}
+
+type giVersionSummaryModel struct {
+	Version types.String `tfsdk:"version"`
+}
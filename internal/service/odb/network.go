@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_odb_network", name="Network")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;awstypes;awstypes.OdbNetwork")
+// @Testing(importIgnore="delete_associated_resources")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newNetworkResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &networkResource{}
+
+	r.SetDefaultCreateTimeout(24 * time.Hour)
+	r.SetDefaultUpdateTimeout(24 * time.Hour)
+	r.SetDefaultDeleteTimeout(24 * time.Hour)
+
+	return r, nil
+}
+
+type networkResource struct {
+	framework.ResourceWithModel[networkResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *networkResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("availability_zone_id")),
+				},
+			},
+			"availability_zone_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_subnet_cidr": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_subnet_cidr": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_domain_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_dns_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_associated_resources": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"oci_network_anchor_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_network_anchor_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_resource_anchor_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_vcn_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oci_vcn_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_access": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Access](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"zero_etl_access": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Access](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *networkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data networkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.DisplayName)
+	var input odb.CreateOdbNetworkInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateOdbNetwork(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ODB Network (%s)", name), err.Error())
+
+		return
+	}
+
+	networkID := aws.ToString(output.OdbNetworkId)
+	data.ID = fwflex.StringValueToFramework(ctx, networkID)
+
+	network, err := waitNetworkCreated(ctx, conn, networkID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), networkID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Network (%s) create", networkID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, network, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.flattenManagedServices(network.ManagedServices)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *networkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data networkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	networkID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findNetworkByID(ctx, conn, networkID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ODB Network (%s)", networkID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.flattenManagedServices(output.ManagedServices)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old networkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	networkID := fwflex.StringValueFromFramework(ctx, new.ID)
+
+	if !new.DisplayName.Equal(old.DisplayName) ||
+		!new.S3Access.Equal(old.S3Access) ||
+		!new.S3PolicyDocument.Equal(old.S3PolicyDocument) ||
+		!new.ZeroETLAccess.Equal(old.ZeroETLAccess) {
+		input := odb.UpdateOdbNetworkInput{
+			OdbNetworkId: aws.String(networkID),
+		}
+		if !new.DisplayName.Equal(old.DisplayName) {
+			input.DisplayName = fwflex.StringFromFramework(ctx, new.DisplayName)
+		}
+		if !new.S3Access.Equal(old.S3Access) {
+			input.S3Access = new.S3Access.ValueEnum()
+		}
+		if !new.S3PolicyDocument.Equal(old.S3PolicyDocument) {
+			input.S3PolicyDocument = fwflex.StringFromFramework(ctx, new.S3PolicyDocument)
+		}
+		if !new.ZeroETLAccess.Equal(old.ZeroETLAccess) {
+			input.ZeroEtlAccess = new.ZeroETLAccess.ValueEnum()
+		}
+
+		_, err := conn.UpdateOdbNetwork(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ODB Network (%s)", networkID), err.Error())
+
+			return
+		}
+
+		if _, err := waitNetworkUpdated(ctx, conn, networkID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Network (%s) update", networkID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *networkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data networkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	networkID := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := odb.DeleteOdbNetworkInput{
+		DeleteAssociatedResources: fwflex.BoolFromFramework(ctx, data.DeleteAssociatedResources),
+		OdbNetworkId:              aws.String(networkID),
+	}
+	_, err := conn.DeleteOdbNetwork(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ODB Network (%s)", networkID), err.Error())
+
+		return
+	}
+
+	if _, err := waitNetworkDeleted(ctx, conn, networkID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Network (%s) delete", networkID), err.Error())
+
+		return
+	}
+}
+
+// accessFromManagedResourceStatus maps the status of a managed service to the access setting that produced it.
+func accessFromManagedResourceStatus(status awstypes.ManagedResourceStatus) awstypes.Access {
+	switch status {
+	case awstypes.ManagedResourceStatusEnabled, awstypes.ManagedResourceStatusEnabling:
+		return awstypes.AccessEnabled
+	default:
+		return awstypes.AccessDisabled
+	}
+}
+
+func findNetworkByID(ctx context.Context, conn *odb.Client, id string) (*awstypes.OdbNetwork, error) {
+	input := odb.GetOdbNetworkInput{
+		OdbNetworkId: aws.String(id),
+	}
+	output, err := conn.GetOdbNetwork(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OdbNetwork == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.OdbNetwork.Status; status == awstypes.ResourceStatusTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.OdbNetwork, nil
+}
+
+func statusNetwork(ctx context.Context, conn *odb.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findNetworkByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitNetworkCreated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.OdbNetwork, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusProvisioning),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusNetwork(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OdbNetwork); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkUpdated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.OdbNetwork, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusUpdating),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusNetwork(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OdbNetwork); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkDeleted(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.OdbNetwork, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusTerminating),
+		Target:  []string{},
+		Refresh: statusNetwork(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OdbNetwork); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type networkResourceModel struct {
+	framework.WithRegionModel
+	ARN                       types.String                        `tfsdk:"arn" autoflex:"name=OdbNetworkArn"`
+	AvailabilityZone          types.String                        `tfsdk:"availability_zone"`
+	AvailabilityZoneID        types.String                        `tfsdk:"availability_zone_id"`
+	BackupSubnetCIDR          types.String                        `tfsdk:"backup_subnet_cidr"`
+	ClientSubnetCIDR          types.String                        `tfsdk:"client_subnet_cidr"`
+	CreatedAt                 timetypes.RFC3339                   `tfsdk:"created_at"`
+	CustomDomainName          types.String                        `tfsdk:"custom_domain_name"`
+	DefaultDNSPrefix          types.String                        `tfsdk:"default_dns_prefix"`
+	DeleteAssociatedResources types.Bool                          `tfsdk:"delete_associated_resources" autoflex:"-"`
+	DisplayName               types.String                        `tfsdk:"display_name"`
+	ID                        types.String                        `tfsdk:"id" autoflex:"name=OdbNetworkId"`
+	OCINetworkAnchorID        types.String                        `tfsdk:"oci_network_anchor_id"`
+	OCINetworkAnchorURL       types.String                        `tfsdk:"oci_network_anchor_url"`
+	OCIResourceAnchorName     types.String                        `tfsdk:"oci_resource_anchor_name"`
+	OCIVCNID                  types.String                        `tfsdk:"oci_vcn_id"`
+	OCIVCNURL                 types.String                        `tfsdk:"oci_vcn_url"`
+	S3Access                  fwtypes.StringEnum[awstypes.Access] `tfsdk:"s3_access"`
+	S3PolicyDocument          fwtypes.IAMPolicy                   `tfsdk:"s3_policy_document"`
+	Tags                      tftags.Map                          `tfsdk:"tags"`
+	TagsAll                   tftags.Map                          `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                      `tfsdk:"timeouts"`
+	ZeroETLAccess             fwtypes.StringEnum[awstypes.Access] `tfsdk:"zero_etl_access"`
+}
+
+func (m *networkResourceModel) flattenManagedServices(apiObject *awstypes.ManagedServices) {
+	m.S3Access = fwtypes.StringEnumValue(awstypes.AccessDisabled)
+	m.ZeroETLAccess = fwtypes.StringEnumValue(awstypes.AccessDisabled)
+
+	if apiObject == nil {
+		return
+	}
+
+	if v := apiObject.S3Access; v != nil {
+		m.S3Access = fwtypes.StringEnumValue(accessFromManagedResourceStatus(v.Status))
+		if v := aws.ToString(v.S3PolicyDocument); v != "" {
+			m.S3PolicyDocument = fwtypes.IAMPolicyValue(v)
+		}
+	}
+	if v := apiObject.ZeroEtlAccess; v != nil {
+		m.ZeroETLAccess = fwtypes.StringEnumValue(accessFromManagedResourceStatus(v.Status))
+	}
+}
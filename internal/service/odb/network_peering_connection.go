@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_odb_network_peering_connection", name="Network Peering Connection")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/odb/types;awstypes;awstypes.OdbPeeringConnection")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newNetworkPeeringConnectionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &networkPeeringConnectionResource{}
+
+	r.SetDefaultCreateTimeout(24 * time.Hour)
+	r.SetDefaultDeleteTimeout(24 * time.Hour)
+
+	return r, nil
+}
+
+type networkPeeringConnectionResource struct {
+	framework.ResourceWithModel[networkPeeringConnectionResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByIdentity
+}
+
+func (r *networkPeeringConnectionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"odb_network_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"odb_network_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"odb_peering_connection_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_network_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_network_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *networkPeeringConnectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data networkPeeringConnectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	var input odb.CreateOdbPeeringConnectionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateOdbPeeringConnection(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating ODB Network (%s) Peering Connection", fwflex.StringValueFromFramework(ctx, data.ODBNetworkID)), err.Error())
+
+		return
+	}
+
+	peeringConnectionID := aws.ToString(output.OdbPeeringConnectionId)
+	data.ID = fwflex.StringValueToFramework(ctx, peeringConnectionID)
+
+	peeringConnection, err := waitNetworkPeeringConnectionCreated(ctx, conn, peeringConnectionID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), peeringConnectionID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Network Peering Connection (%s) create", peeringConnectionID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, peeringConnection, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *networkPeeringConnectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data networkPeeringConnectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	peeringConnectionID := fwflex.StringValueFromFramework(ctx, data.ID)
+	output, err := findNetworkPeeringConnectionByID(ctx, conn, peeringConnectionID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ODB Network Peering Connection (%s)", peeringConnectionID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// The API returns only the ARNs of the peered networks.
+	if data.ODBNetworkID.IsNull() {
+		data.ODBNetworkID = fwflex.StringValueToFramework(ctx, resourceIDFromARN(aws.ToString(output.OdbNetworkArn)))
+	}
+	if data.PeerNetworkID.IsNull() {
+		data.PeerNetworkID = fwflex.StringValueToFramework(ctx, resourceIDFromARN(aws.ToString(output.PeerNetworkArn)))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkPeeringConnectionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+	var data networkPeeringConnectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkPeeringConnectionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data networkPeeringConnectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ODBClient(ctx)
+
+	peeringConnectionID := fwflex.StringValueFromFramework(ctx, data.ID)
+	input := odb.DeleteOdbPeeringConnectionInput{
+		OdbPeeringConnectionId: aws.String(peeringConnectionID),
+	}
+	_, err := conn.DeleteOdbPeeringConnection(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ODB Network Peering Connection (%s)", peeringConnectionID), err.Error())
+
+		return
+	}
+
+	if _, err := waitNetworkPeeringConnectionDeleted(ctx, conn, peeringConnectionID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ODB Network Peering Connection (%s) delete", peeringConnectionID), err.Error())
+
+		return
+	}
+}
+
+// resourceIDFromARN returns the trailing resource ID of an ARN such as "arn:aws:odb:us-east-1:123456789012:odb-network/odbnet_xxxx".
+func resourceIDFromARN(s string) string {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return s
+	}
+
+	if _, id, ok := strings.Cut(v.Resource, "/"); ok {
+		return id
+	}
+
+	return v.Resource
+}
+
+func findNetworkPeeringConnectionByID(ctx context.Context, conn *odb.Client, id string) (*awstypes.OdbPeeringConnection, error) {
+	input := odb.GetOdbPeeringConnectionInput{
+		OdbPeeringConnectionId: aws.String(id),
+	}
+	output, err := conn.GetOdbPeeringConnection(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OdbPeeringConnection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.OdbPeeringConnection.Status; status == awstypes.ResourceStatusTerminated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.OdbPeeringConnection, nil
+}
+
+func statusNetworkPeeringConnection(ctx context.Context, conn *odb.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findNetworkPeeringConnectionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitNetworkPeeringConnectionCreated(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.OdbPeeringConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusProvisioning),
+		Target:  enum.Slice(awstypes.ResourceStatusAvailable),
+		Refresh: statusNetworkPeeringConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OdbPeeringConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkPeeringConnectionDeleted(ctx context.Context, conn *odb.Client, id string, timeout time.Duration) (*awstypes.OdbPeeringConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceStatusTerminating),
+		Target:  []string{},
+		Refresh: statusNetworkPeeringConnection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.OdbPeeringConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type networkPeeringConnectionResourceModel struct {
+	framework.WithRegionModel
+	ARN                      types.String      `tfsdk:"arn" autoflex:"name=OdbPeeringConnectionArn"`
+	CreatedAt                timetypes.RFC3339 `tfsdk:"created_at"`
+	DisplayName              types.String      `tfsdk:"display_name"`
+	ID                       types.String      `tfsdk:"id" autoflex:"name=OdbPeeringConnectionId"`
+	ODBNetworkARN            types.String      `tfsdk:"odb_network_arn"`
+	ODBNetworkID             types.String      `tfsdk:"odb_network_id"`
+	ODBPeeringConnectionType types.String      `tfsdk:"odb_peering_connection_type"`
+	PeerNetworkARN           types.String      `tfsdk:"peer_network_arn"`
+	PeerNetworkID            types.String      `tfsdk:"peer_network_id"`
+	Tags                     tftags.Map        `tfsdk:"tags"`
+	TagsAll                  tftags.Map        `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value    `tfsdk:"timeouts"`
+}
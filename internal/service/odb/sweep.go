@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_odb_cloud_autonomous_vm_cluster", sweepCloudAutonomousVMClusters)
+
+	awsv2.Register("aws_odb_cloud_exadata_infrastructure", sweepCloudExadataInfrastructures,
+		"aws_odb_cloud_autonomous_vm_cluster",
+		"aws_odb_cloud_vm_cluster",
+	)
+
+	awsv2.Register("aws_odb_cloud_vm_cluster", sweepCloudVMClusters)
+
+	awsv2.Register("aws_odb_network", sweepNetworks,
+		"aws_odb_cloud_autonomous_vm_cluster",
+		"aws_odb_cloud_vm_cluster",
+		"aws_odb_network_peering_connection",
+	)
+
+	awsv2.Register("aws_odb_network_peering_connection", sweepNetworkPeeringConnections)
+}
+
+func sweepCloudAutonomousVMClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ODBClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := odb.NewListCloudAutonomousVmClustersPaginator(conn, &odb.ListCloudAutonomousVmClustersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CloudAutonomousVmClusters {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newCloudAutonomousVMClusterResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.CloudAutonomousVmClusterId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepCloudExadataInfrastructures(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ODBClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := odb.NewListCloudExadataInfrastructuresPaginator(conn, &odb.ListCloudExadataInfrastructuresInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CloudExadataInfrastructures {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newCloudExadataInfrastructureResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.CloudExadataInfrastructureId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepCloudVMClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ODBClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := odb.NewListCloudVmClustersPaginator(conn, &odb.ListCloudVmClustersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CloudVmClusters {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newCloudVMClusterResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.CloudVmClusterId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepNetworks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ODBClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := odb.NewListOdbNetworksPaginator(conn, &odb.ListOdbNetworksInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.OdbNetworks {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newNetworkResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.OdbNetworkId)),
+				framework.NewAttribute("delete_associated_resources", true),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepNetworkPeeringConnections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ODBClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := odb.NewListOdbPeeringConnectionsPaginator(conn, &odb.ListOdbPeeringConnectionsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.OdbPeeringConnections {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newNetworkPeeringConnectionResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.OdbPeeringConnectionId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
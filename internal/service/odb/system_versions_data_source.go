@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_odb_system_versions", name="System Versions")
+func newSystemVersionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &systemVersionsDataSource{}, nil
+}
+
+type systemVersionsDataSource struct {
+	framework.DataSourceWithModel[systemVersionsDataSourceModel]
+}
+
+func (d *systemVersionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"gi_version": schema.StringAttribute{
+				Required: true,
+			},
+			"shape": schema.StringAttribute{
+				Required: true,
+			},
+			"system_versions": framework.DataSourceComputedListOfObjectAttribute[systemVersionSummaryModel](ctx),
+		},
+	}
+}
+
+func (d *systemVersionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data systemVersionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ODBClient(ctx)
+
+	var input odb.ListSystemVersionsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findSystemVersions(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ODB System Versions", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.SystemVersions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSystemVersions(ctx context.Context, conn *odb.Client, input *odb.ListSystemVersionsInput) ([]awstypes.SystemVersionSummary, error) {
+	var output []awstypes.SystemVersionSummary
+
+	pages := odb.NewListSystemVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SystemVersions...)
+	}
+
+	return output, nil
+}
+
+type systemVersionsDataSourceModel struct {
+	framework.WithRegionModel
+	GIVersion      types.String                                               `tfsdk:"gi_version"`
+	Shape          types.String                                               `tfsdk:"shape"`
+	SystemVersions fwtypes.ListNestedObjectValueOf[systemVersionSummaryModel] `tfsdk:"system_versions" autoflex:"-"`
+}
+
+type systemVersionSummaryModel struct {
+	GIVersion      types.String         `tfsdk:"gi_version"`
+	Shape          types.String         `tfsdk:"shape"`
+	SystemVersions fwtypes.ListOfString `tfsdk:"system_versions"`
+}
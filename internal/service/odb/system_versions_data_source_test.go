@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package odb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccODBSystemVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_odb_system_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ODBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemVersionsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "system_versions.#", 1),
+					resource.TestCheckResourceAttr(dataSourceName, "system_versions.0.shape", "Exadata.X9M"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "system_versions.0.system_versions.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSystemVersionsDataSourceConfig_basic = `
+data "aws_odb_system_versions" "test" {
+  gi_version = "23.0.0.0"
+  shape      = "Exadata.X9M"
+}
+`
@@ -0,0 +1,71 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_odb_cloud_autonomous_vm_cluster" "test" {
+  region = var.region
+
+  display_name                          = var.rName
+  cloud_exadata_infrastructure_id       = aws_odb_cloud_exadata_infrastructure.test.id
+  odb_network_id                        = aws_odb_network.test.id
+  autonomous_data_storage_size_in_tbs   = 5
+  cpu_core_count_per_node               = 40
+  memory_per_oracle_compute_unit_in_gbs = 2
+  total_container_databases             = 1
+  db_servers                            = data.aws_odb_db_servers.test.db_servers[*].id
+
+  maintenance_window {
+    preference = "NO_PREFERENCE"
+  }
+}
+
+data "aws_odb_db_servers" "test" {
+  region = var.region
+
+  cloud_exadata_infrastructure_id = aws_odb_cloud_exadata_infrastructure.test.id
+}
+
+resource "aws_odb_cloud_exadata_infrastructure" "test" {
+  region = var.region
+
+  display_name         = var.rName
+  shape                = "Exadata.X9M"
+  compute_count        = 2
+  storage_count        = 3
+  availability_zone_id = data.aws_availability_zones.available.zone_ids[0]
+
+  maintenance_window {
+    preference = "NO_PREFERENCE"
+  }
+}
+
+resource "aws_odb_network" "test" {
+  region = var.region
+
+  display_name         = var.rName
+  availability_zone_id = data.aws_availability_zones.available.zone_ids[0]
+  client_subnet_cidr   = "10.2.0.0/24"
+  backup_subnet_cidr   = "10.2.1.0/24"
+}
+
+data "aws_availability_zones" "available" {
+  region = var.region
+
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
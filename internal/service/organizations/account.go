@@ -34,9 +34,12 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 // @Tags(identifierAttribute="id")
 // @IdentityAttribute("id")
 // @CustomImport
-// @Testing(tagsTest=false, identityTest=false)
+// @Testing(tagsTest=false)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/organizations/types;awstypes;awstypes.Account")
 // @Testing(serialize=true)
+// @Testing(preIdentityVersion="v5.100.0")
+// @Testing(requireEnvVar="TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN")
+// @Testing(importIgnore="close_on_deletion;create_govcloud;govcloud_id")
 // @Testing(preCheck="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckOrganizationsEnabled")
 func resourceAccount() *schema.Resource {
 	return &schema.Resource{
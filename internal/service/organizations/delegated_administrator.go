@@ -30,9 +30,9 @@ import (
 // @IdentityAttribute("delegated_account_id", resourceAttributeName="account_id")
 // @IdAttrFormat("{account_id}/{service_principal}")
 // @ImportIDHandler("delegatedAdministratorImportID")
-// @Testing(identityTest=false)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/organizations/types;awstypes;awstypes.DelegatedAdministrator")
 // @Testing(serialize=true)
+// @Testing(preIdentityVersion="6.4.0")
 // @Testing(useAlternateAccount=true)
 // @Testing(preCheck="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckOrganizationManagementAccount")
 // @Testing(generator=false)
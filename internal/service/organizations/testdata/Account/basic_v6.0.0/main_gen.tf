@@ -0,0 +1,29 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_organizations_account" "test" {
+  name              = var.rName
+  email             = "${var.rName}@${var.TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN}"
+  close_on_deletion = true
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN" {
+  type     = string
+  nullable = false
+}
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.0.0"
+    }
+  }
+}
+
+provider "aws" {}
@@ -0,0 +1,46 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_organizations_delegated_administrator" "test" {
+  account_id        = data.aws_caller_identity.delegated.account_id
+  service_principal = "securitylake.amazonaws.com"
+}
+
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+provider "awsalternate" {
+  access_key = var.AWS_ALTERNATE_ACCESS_KEY_ID
+  profile    = var.AWS_ALTERNATE_PROFILE
+  secret_key = var.AWS_ALTERNATE_SECRET_ACCESS_KEY
+}
+
+variable "AWS_ALTERNATE_ACCESS_KEY_ID" {
+  type     = string
+  nullable = true
+  default  = null
+}
+
+variable "AWS_ALTERNATE_PROFILE" {
+  type     = string
+  nullable = true
+  default  = null
+}
+
+variable "AWS_ALTERNATE_SECRET_ACCESS_KEY" {
+  type     = string
+  nullable = true
+  default  = null
+}
+
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.4.0"
+    }
+  }
+}
+
+provider "aws" {}
@@ -20,12 +20,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -297,7 +299,7 @@ func findExportTasks(ctx context.Context, conn *rds.Client, input *rds.DescribeE
 	return output, nil
 }
 
-func statusExportTask(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+func statusExportTask(ctx context.Context, conn *rds.Client, id string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		out, err := findExportTaskByID(ctx, conn, id)
 
@@ -314,7 +316,7 @@ func statusExportTask(ctx context.Context, conn *rds.Client, id string) retry.St
 }
 
 func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending:    []string{exportTaskStatusStarting, exportTaskStatusInProgress},
 		Target:     []string{exportTaskStatusComplete, exportTaskStatusFailed},
 		Refresh:    statusExportTask(ctx, conn, id),
@@ -323,7 +325,7 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, exportTaskWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.ExportTask); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureCause)))
@@ -334,8 +336,22 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 	return nil, err
 }
 
+// exportTaskWaitStatus returns the status reported while waiting for an export task.
+func exportTaskWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*awstypes.ExportTask); ok {
+		if output.PercentProgress != nil {
+			status.PercentComplete = aws.Int(int(aws.ToInt32(output.PercentProgress)))
+		}
+		status.Reason = aws.ToString(output.WarningMessage)
+	}
+
+	return status
+}
+
 func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{exportTaskStatusStarting, exportTaskStatusInProgress, exportTaskStatusCanceling},
 		Target:  []string{},
 		Refresh: statusExportTask(ctx, conn, id),
@@ -23,15 +23,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -1939,10 +1941,12 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		v, err = findDBInstanceByID(ctx, conn, d.Id())
 	} else {
 		v, err = findDBInstanceByID(ctx, conn, d.Id())
-		if tfresource.NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		//lintignore:AWSR004
+		if tfresource.NotFound(err) {
 			// Retry with `identifier`
 			v, err = findDBInstanceByID(ctx, conn, d.Get(names.AttrIdentifier).(string))
-			if tfresource.NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+			//lintignore:AWSR004
+			if tfresource.NotFound(err) {
 				log.Printf("[WARN] RDS DB Instance (%s) not found, removing from state", d.Get(names.AttrIdentifier).(string))
 				d.SetId("")
 				return diags
@@ -2848,7 +2852,7 @@ func findDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeD
 		page, err := pages.NextPage(ctx, optFns...)
 
 		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
-			return nil, &retry.NotFoundError{
+			return nil, &sdkretry.NotFoundError{
 				LastError:   err,
 				LastRequest: input,
 			}
@@ -2868,7 +2872,7 @@ func findDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeD
 	return output, nil
 }
 
-func statusDBInstance(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
+func statusDBInstance(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findDBInstanceByID(ctx, conn, id, optFns...)
 
@@ -2884,6 +2888,27 @@ func statusDBInstance(ctx context.Context, conn *rds.Client, id string, optFns .
 	}
 }
 
+// dbInstanceWaitStatus returns the status reported while waiting for a DB instance.
+func dbInstanceWaitStatus(v any) backoff.Status {
+	var status backoff.Status
+
+	if output, ok := v.(*types.DBInstance); ok {
+		if n, err := strconv.Atoi(aws.ToString(output.PercentProgress)); err == nil {
+			status.PercentComplete = aws.Int(n)
+		}
+
+		var reasons []string
+		for _, apiObject := range output.StatusInfos {
+			if !aws.ToBool(apiObject.Normal) {
+				reasons = append(reasons, fmt.Sprintf("%s: %s: %s", aws.ToString(apiObject.StatusType), aws.ToString(apiObject.Status), aws.ToString(apiObject.Message)))
+			}
+		}
+		status.Reason = strings.Join(reasons, ", ")
+	}
+
+	return status
+}
+
 func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2894,7 +2919,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{
 			instanceStatusBackingUp,
 			instanceStatusConfiguringEnhancedMonitoring,
@@ -2918,7 +2943,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, dbInstanceWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
@@ -2928,7 +2953,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 }
 
 func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{
 			instanceStatusBackingUp,
 			instanceStatusConfiguringEnhancedMonitoring,
@@ -2954,7 +2979,7 @@ func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, tim
 		MinTimeout:                3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, dbInstanceWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
@@ -2973,7 +2998,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.Client, id string, tim
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{
 			instanceStatusAvailable,
 			instanceStatusBackingUp,
@@ -2996,7 +3021,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.Client, id string, tim
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := retry.StateChangeConfFromSDKv2(stateConf, dbInstanceWaitStatus).WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
@@ -3018,7 +3043,7 @@ func findBlueGreenDeploymentByID(ctx context.Context, conn *rds.Client, id strin
 
 	// Eventual consistency check.
 	if aws.ToString(output.BlueGreenDeploymentIdentifier) != id {
-		return nil, &retry.NotFoundError{
+		return nil, &sdkretry.NotFoundError{
 			LastRequest: input,
 		}
 	}
@@ -3044,7 +3069,7 @@ func findBlueGreenDeployments(ctx context.Context, conn *rds.Client, input *rds.
 		page, err := pages.NextPage(ctx)
 
 		if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
-			return nil, &retry.NotFoundError{
+			return nil, &sdkretry.NotFoundError{
 				LastError:   err,
 				LastRequest: input,
 			}
@@ -3064,7 +3089,7 @@ func findBlueGreenDeployments(ctx context.Context, conn *rds.Client, input *rds.
 	return output, nil
 }
 
-func statusBlueGreenDeployment(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+func statusBlueGreenDeployment(ctx context.Context, conn *rds.Client, id string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findBlueGreenDeploymentByID(ctx, conn, id)
 
@@ -3088,7 +3113,7 @@ func waitBlueGreenDeploymentAvailable(ctx context.Context, conn *rds.Client, id
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{"PROVISIONING"},
 		Target:  []string{"AVAILABLE"},
 		Refresh: statusBlueGreenDeployment(ctx, conn, id),
@@ -3114,7 +3139,7 @@ func waitBlueGreenDeploymentSwitchoverCompleted(ctx context.Context, conn *rds.C
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{"AVAILABLE", "SWITCHOVER_IN_PROGRESS"},
 		Target:  []string{"SWITCHOVER_COMPLETED"},
 		Refresh: statusBlueGreenDeployment(ctx, conn, id),
@@ -3144,7 +3169,7 @@ func waitBlueGreenDeploymentDeleted(ctx context.Context, conn *rds.Client, id st
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &sdkretry.StateChangeConf{
 		Pending: []string{"PROVISIONING", "AVAILABLE", "SWITCHOVER_IN_PROGRESS", "SWITCHOVER_COMPLETED", "INVALID_CONFIGURATION", "SWITCHOVER_FAILED", "DELETING"},
 		Target:  []string{},
 		Refresh: statusBlueGreenDeployment(ctx, conn, id),
@@ -262,7 +262,10 @@ func (r *resiliencyPolicyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	plan.flattenPolicy(ctx, created.Policy)
+	resp.Diagnostics.Append(plan.flattenPolicy(ctx, created.Policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -295,7 +298,10 @@ func (r *resiliencyPolicyResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	state.flattenPolicy(ctx, out.Policy)
+	resp.Diagnostics.Append(state.flattenPolicy(ctx, out.Policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -377,7 +383,10 @@ func (r *resiliencyPolicyResource) Update(ctx context.Context, req resource.Upda
 			return
 		}
 
-		state.flattenPolicy(ctx, updated.Policy)
+		resp.Diagnostics.Append(state.flattenPolicy(ctx, updated.Policy)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	} else {
 		// Explicitly copy computed arguments for tag-only updates
 		plan.EstimatedCostTier = state.EstimatedCostTier
@@ -541,18 +550,12 @@ func (m *policyData) expandPolicy(ctx context.Context) (result map[string]awstyp
 			if d.HasError() {
 				return result, diags
 			}
-			rpo, d := resObjModel.Rpo.ValueGoDuration()
-			if d.HasError() {
-				return result, diags
-			}
-			rto, d := resObjModel.Rto.ValueGoDuration()
-			if d.HasError() {
+			var apiObject awstypes.FailurePolicy
+			diags.Append(flex.Expand(ctx, resObjModel, &apiObject)...)
+			if diags.HasError() {
 				return result, diags
 			}
-			failurePolicy[string(k)] = awstypes.FailurePolicy{
-				RpoInSecs: int32(rpo.Seconds()),
-				RtoInSecs: int32(rto.Seconds()),
-			}
+			failurePolicy[string(k)] = apiObject
 		}
 	}
 
@@ -561,19 +564,16 @@ func (m *policyData) expandPolicy(ctx context.Context) (result map[string]awstyp
 	return result, diags
 }
 
-func (m *resiliencyPolicyResourceModel) flattenPolicy(ctx context.Context, failurePolicy map[string]awstypes.FailurePolicy) {
+func (m *resiliencyPolicyResourceModel) flattenPolicy(ctx context.Context, failurePolicy map[string]awstypes.FailurePolicy) (diags diag.Diagnostics) {
 	if len(failurePolicy) == 0 {
 		m.Policy = fwtypes.NewListNestedObjectValueOfNull[policyData](ctx)
 	}
 
 	newResObjModel := func(policyType awstypes.TestType, failurePolicy map[string]awstypes.FailurePolicy) fwtypes.ListNestedObjectValueOf[resiliencyObjectiveData] {
 		if pv, exists := failurePolicy[string(policyType)]; exists {
-			return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*resiliencyObjectiveData{
-				{
-					Rpo: timetypes.NewGoDurationValue(time.Duration(pv.RpoInSecs) * time.Second),
-					Rto: timetypes.NewGoDurationValue(time.Duration(pv.RtoInSecs) * time.Second),
-				},
-			})
+			var resObjModel resiliencyObjectiveData
+			diags.Append(flex.Flatten(ctx, pv, &resObjModel)...)
+			return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &resObjModel)
 		} else {
 			return fwtypes.NewListNestedObjectValueOfNull[resiliencyObjectiveData](ctx)
 		}
@@ -587,6 +587,8 @@ func (m *resiliencyPolicyResourceModel) flattenPolicy(ctx context.Context, failu
 			newResObjModel(awstypes.TestTypeRegion, failurePolicy),
 		},
 	})
+
+	return diags
 }
 
 type resiliencyPolicyResourceModel struct {
@@ -611,6 +613,6 @@ type policyData struct {
 }
 
 type resiliencyObjectiveData struct {
-	Rpo timetypes.GoDuration `tfsdk:"rpo"`
-	Rto timetypes.GoDuration `tfsdk:"rto"`
+	Rpo timetypes.GoDuration `tfsdk:"rpo" autoflex:"name=RpoInSecs,convert=duration_seconds"`
+	Rto timetypes.GoDuration `tfsdk:"rto" autoflex:"name=RtoInSecs,convert=duration_seconds"`
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -472,6 +473,13 @@ func waitBucketMetadataInventoryTableConfigurationCreated(ctx context.Context, c
 		Refresh:                   statusBucketMetadataInventoryTableConfiguration(conn, bucket, expectedBucketOwner),
 		Timeout:                   timeout,
 		ContinuousTargetOccurence: 2,
+		Status: func(v any) backoff.Status {
+			var status backoff.Status
+			if output, ok := v.(*awstypes.InventoryTableConfigurationResult); ok && output.Error != nil {
+				status.Reason = fmt.Sprintf("%s: %s", aws.ToString(output.Error.ErrorCode), aws.ToString(output.Error.ErrorMessage))
+			}
+			return status
+		},
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -494,6 +502,13 @@ func waitBucketMetadataJournalTableConfigurationCreated(ctx context.Context, con
 		Refresh:                   statusBucketMetadataJournalTableConfiguration(conn, bucket, expectedBucketOwner),
 		Timeout:                   timeout,
 		ContinuousTargetOccurence: 2,
+		Status: func(v any) backoff.Status {
+			var status backoff.Status
+			if output, ok := v.(*awstypes.JournalTableConfigurationResult); ok && output.Error != nil {
+				status.Reason = fmt.Sprintf("%s: %s", aws.ToString(output.Error.ErrorCode), aws.ToString(output.Error.ErrorMessage))
+			}
+			return status
+		},
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
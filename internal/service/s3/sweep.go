@@ -73,6 +73,7 @@ func sweepGeneralPurposeBucketObjects(ctx context.Context, client *conns.AWSClie
 
 			var objectLockEnabled bool
 			objLockConfig, err := findObjectLockConfiguration(ctx, conn, bucketName, "")
+			//lintignore:AWSR004
 			if !tfresource.NotFound(err) {
 				if err != nil {
 					tflog.Warn(ctx, "Reading S3 Bucket Object Lock Configuration", map[string]any{
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3vectors/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3vectors_index", name="Index")
+func newIndexDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &indexDataSource{}, nil
+}
+
+type indexDataSource struct {
+	framework.DataSourceWithModel[indexDataSourceModel]
+}
+
+func (d *indexDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"data_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataType](),
+				Computed:   true,
+			},
+			"dimension": schema.Int32Attribute{
+				Computed: true,
+			},
+			"distance_metric": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DistanceMetric](),
+				Computed:   true,
+			},
+			"index_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"index_name": schema.StringAttribute{
+				Required: true,
+			},
+			"metadata_configuration": framework.DataSourceComputedListOfObjectAttribute[metadataConfigurationModel](ctx),
+			"vector_bucket_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *indexDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data indexDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3VectorsClient(ctx)
+
+	bucketName, name := fwflex.StringValueFromFramework(ctx, data.VectorBucketName), fwflex.StringValueFromFramework(ctx, data.IndexName)
+	output, err := findIndexByTwoPartKey(ctx, conn, bucketName, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Index (%s/%s)", bucketName, name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type indexDataSourceModel struct {
+	framework.WithRegionModel
+	CreationTime          timetypes.RFC3339                                           `tfsdk:"creation_time"`
+	DataType              fwtypes.StringEnum[awstypes.DataType]                       `tfsdk:"data_type"`
+	Dimension             types.Int32                                                 `tfsdk:"dimension"`
+	DistanceMetric        fwtypes.StringEnum[awstypes.DistanceMetric]                 `tfsdk:"distance_metric"`
+	IndexARN              types.String                                                `tfsdk:"index_arn"`
+	IndexName             types.String                                                `tfsdk:"index_name"`
+	MetadataConfiguration fwtypes.ListNestedObjectValueOf[metadataConfigurationModel] `tfsdk:"metadata_configuration"`
+	VectorBucketName      types.String                                                `tfsdk:"vector_bucket_name"`
+}
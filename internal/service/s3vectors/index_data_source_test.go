@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3VectorsIndexDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3vectors_index.test"
+	resourceName := "aws_s3vectors_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreationTime, resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_type", resourceName, "data_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dimension", resourceName, "dimension"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "distance_metric", resourceName, "distance_metric"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_arn", resourceName, "index_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_name", resourceName, "index_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_configuration.0.non_filterable_metadata_keys.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vector_bucket_name", resourceName, "vector_bucket_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIndexDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_metadataConfiguration(rName), `
+data "aws_s3vectors_index" "test" {
+  index_name         = aws_s3vectors_index.test.index_name
+  vector_bucket_name = aws_s3vectors_index.test.vector_bucket_name
+}
+`)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_s3vectors_index", sweepIndexes)
+
+	awsv2.Register("aws_s3vectors_vector_bucket", sweepVectorBuckets,
+		"aws_s3vectors_index",
+	)
+}
+
+func sweepIndexes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3VectorsClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	vectorBuckets := s3vectors.NewListVectorBucketsPaginator(conn, &s3vectors.ListVectorBucketsInput{})
+	for vectorBuckets.HasMorePages() {
+		page, err := vectorBuckets.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bucket := range page.VectorBuckets {
+			indexes := s3vectors.NewListIndexesPaginator(conn, &s3vectors.ListIndexesInput{
+				VectorBucketArn: bucket.VectorBucketArn,
+			})
+			for indexes.HasMorePages() {
+				page, err := indexes.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, index := range page.Indexes {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newIndexResource, client,
+						framework.NewAttribute("index_arn", aws.ToString(index.IndexArn)),
+					))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVectorBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3VectorsClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := s3vectors.NewListVectorBucketsPaginator(conn, &s3vectors.ListVectorBucketsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bucket := range page.VectorBuckets {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVectorBucketResource, client,
+				framework.NewAttribute("vector_bucket_arn", aws.ToString(bucket.VectorBucketArn)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
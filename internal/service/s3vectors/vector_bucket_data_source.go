@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3vectors_vector_bucket", name="Vector Bucket")
+func newVectorBucketDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &vectorBucketDataSource{}, nil
+}
+
+type vectorBucketDataSource struct {
+	framework.DataSourceWithModel[vectorBucketDataSourceModel]
+}
+
+func (d *vectorBucketDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrEncryptionConfiguration: framework.DataSourceComputedListOfObjectAttribute[encryptionConfigurationModel](ctx),
+			"vector_bucket_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"vector_bucket_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *vectorBucketDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data vectorBucketDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3VectorsClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.VectorBucketName)
+	output, err := findVectorBucketByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Vectors Vector Bucket (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type vectorBucketDataSourceModel struct {
+	framework.WithRegionModel
+	CreationTime            timetypes.RFC3339                                             `tfsdk:"creation_time"`
+	EncryptionConfiguration fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	VectorBucketARN         types.String                                                  `tfsdk:"vector_bucket_arn"`
+	VectorBucketName        types.String                                                  `tfsdk:"vector_bucket_name"`
+}
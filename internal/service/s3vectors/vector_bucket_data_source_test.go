@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3vectors_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3VectorsVectorBucketDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3vectors_vector_bucket.test"
+	resourceName := "aws_s3vectors_vector_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3VectorsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVectorBucketDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreationTime, resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttrPair(dataSourceName, "encryption_configuration.#", resourceName, "encryption_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "encryption_configuration.0.sse_type", resourceName, "encryption_configuration.0.sse_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vector_bucket_arn", resourceName, "vector_bucket_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vector_bucket_name", resourceName, "vector_bucket_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVectorBucketDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVectorBucketConfig_basic(rName), `
+data "aws_s3vectors_vector_bucket" "test" {
+  vector_bucket_name = aws_s3vectors_vector_bucket.test.vector_bucket_name
+}
+`)
+}
@@ -43,9 +43,12 @@ const dataLakeMutexKey = "aws_securitylake_data_lake"
 // @FrameworkResource("aws_securitylake_data_lake", name="Data Lake")
 // @Tags(identifierAttribute="arn")
 // @ArnIdentity(identityDuplicateAttributes="id")
-// @Testing(identityTest=false)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/securitylake/types;awstypes;awstypes.DataLakeResource")
 // @Testing(serialize=true)
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(generator=false)
+// @Testing(preIdentityVersion="v5.100.0")
+// @Testing(importIgnore="meta_store_manager_role_arn")
 func newDataLakeResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &dataLakeResource{}
 
@@ -51,7 +51,7 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"lifecycle":          testAccDataLake_lifeCycle,
 			"metaStoreUpdate":    testAccDataLake_metaStoreUpdate,
 			"replication":        testAccDataLake_replication,
-			"Identity":           testAccDataLake_IdentitySerial,
+			"Identity":           testAccSecurityLakeDataLake_IdentitySerial,
 		},
 		"Subscriber": {
 			"accessType":         testAccSubscriber_accessType,
@@ -0,0 +1,146 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_securitylake_data_lake" "test" {
+  meta_store_manager_role_arn = aws_iam_role.meta_store_manager.arn
+
+  configuration {
+    region = data.aws_region.current.region
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.datalake]
+}
+
+resource "aws_iam_role" "meta_store_manager" {
+  name               = "AmazonSecurityLakeMetaStoreManagerV2"
+  path               = "/service-role/"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowLambda",
+    "Effect": "Allow",
+    "Principal": {
+      "Service": [
+        "lambda.amazonaws.com"
+      ]
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "datalake" {
+  role       = aws_iam_role.meta_store_manager.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonSecurityLakeMetastoreManager"
+}
+
+resource "aws_iam_role" "datalake_s3_replication" {
+  name               = "AmazonSecurityLakeS3ReplicationRole"
+  path               = "/service-role/"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Principal": {
+      "Service": "s3.amazonaws.com"
+    },
+    "Effect": "Allow"
+  }]
+}
+POLICY
+}
+
+# These are required in all configurations because the role stays registered with the Lake Formation Data Lake
+# after the MetaStoreManager role is updated.
+resource "aws_iam_role" "meta_store_manager_updated" {
+  name               = "AmazonSecurityLakeMetaStoreManagerV1"
+  path               = "/service-role/"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowLambda",
+    "Effect": "Allow",
+    "Principal": {
+      "Service": [
+        "lambda.amazonaws.com"
+      ]
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "datalake_updated" {
+  role       = aws_iam_role.meta_store_manager_updated.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonSecurityLakeMetastoreManager"
+}
+
+resource "aws_iam_role_policy" "datalake_s3_replication" {
+  name = "AmazonSecurityLakeS3ReplicationRolePolicy"
+  role = aws_iam_role.datalake_s3_replication.name
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowReadS3ReplicationSetting",
+      "Action": [
+        "s3:ListBucket",
+        "s3:GetReplicationConfiguration",
+        "s3:GetObjectVersionForReplication",
+        "s3:GetObjectVersion",
+        "s3:GetObjectVersionAcl",
+        "s3:GetObjectVersionTagging",
+        "s3:GetObjectRetention",
+        "s3:GetObjectLegalHold"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "arn:${data.aws_partition.current.partition}:s3:::aws-security-data-lake*",
+        "arn:${data.aws_partition.current.partition}:s3:::aws-security-data-lake*/*"
+      ],
+      "Condition": {
+        "StringEquals": {
+          "s3:ResourceAccount": [
+            "${data.aws_caller_identity.current.account_id}"
+          ]
+        }
+      }
+    },
+    {
+      "Sid": "AllowS3Replication",
+      "Action": [
+        "s3:ReplicateObject",
+        "s3:ReplicateDelete",
+        "s3:ReplicateTags"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "arn:${data.aws_partition.current.partition}:s3:::aws-security-data-lake*/*"
+      ],
+      "Condition": {
+        "StringEquals": {
+          "s3:ResourceAccount": [
+            "${data.aws_caller_identity.current.account_id}"
+          ]
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {
+}
+
@@ -62,9 +62,10 @@ func resourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringLenBetween(0, 1024*1024), // 1048576
+				DiffSuppressFunc: sdkv2.SuppressEquivalentStateMachineDefinitions,
 			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLens                = newLensResource
+	ResourceLensShare           = newLensShareResource
+	ResourceProfile             = newProfileResource
+	ResourceProfileShare        = newProfileShareResource
+	ResourceReviewTemplate      = newReviewTemplateResource
+	ResourceReviewTemplateShare = newReviewTemplateShareResource
+	ResourceWorkload            = newWorkloadResource
+	ResourceWorkloadShare       = newWorkloadShareResource
+
+	FindLensByARN                       = findLensByARN
+	FindLensShareByTwoPartKey           = findLensShareByTwoPartKey
+	FindProfileByARN                    = findProfileByARN
+	FindProfileShareByTwoPartKey        = findProfileShareByTwoPartKey
+	FindReviewTemplateByARN             = findReviewTemplateByARN
+	FindReviewTemplateShareByTwoPartKey = findReviewTemplateShareByTwoPartKey
+	FindWorkloadByID                    = findWorkloadByID
+	FindWorkloadShareByTwoPartKey       = findWorkloadShareByTwoPartKey
+)
@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Lens")
+// @Testing(importIgnore="json_string;lens_version;is_major_version")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(hasNoPreExistingResource=true)
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lensResource{}, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithModel[lensResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"is_major_version": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"json_string": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			"lens_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.ImportLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		JSONString:         fwflex.StringFromFramework(ctx, data.JSONString),
+		Tags:               getTagsIn(ctx),
+	}
+	output, err := conn.ImportLens(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("importing Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.LensArn)
+
+	// The import is asynchronous, so wait for the lens to become visible.
+	lens, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func(ctx context.Context) (*awstypes.Lens, error) {
+		return findLensByARN(ctx, conn, arn)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	if version := fwflex.StringValueFromFramework(ctx, data.LensVersion); version != "" {
+		if err := createLensVersion(ctx, conn, arn, version, data.IsMajorVersion.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version (%s)", arn, version), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findLensByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+
+	if !new.JSONString.Equal(old.JSONString) {
+		// Importing over an existing lens replaces its draft.
+		input := wellarchitected.ImportLensInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			JSONString:         fwflex.StringFromFramework(ctx, new.JSONString),
+			LensAlias:          aws.String(arn),
+		}
+		_, err := conn.ImportLens(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("importing Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.LensVersion.Equal(old.LensVersion) {
+		if version := fwflex.StringValueFromFramework(ctx, new.LensVersion); version != "" {
+			if err := createLensVersion(ctx, conn, arn, version, new.IsMajorVersion.ValueBool()); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version (%s)", arn, version), err.Error())
+
+				return
+			}
+		}
+	}
+
+	output, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	input := wellarchitected.DeleteLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          aws.String(arn),
+		LensStatus:         awstypes.LensStatusTypeAll,
+	}
+	_, err := conn.DeleteLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func createLensVersion(ctx context.Context, conn *wellarchitected.Client, arn, version string, isMajorVersion bool) error {
+	input := wellarchitected.CreateLensVersionInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		IsMajorVersion:     aws.Bool(isMajorVersion),
+		LensAlias:          aws.String(arn),
+		LensVersion:        aws.String(version),
+	}
+
+	// A freshly imported draft may still be processing.
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.ConflictException](ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.CreateLensVersion(ctx, &input)
+	})
+
+	return err
+}
+
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Lens, error) {
+	input := wellarchitected.GetLensInput{
+		LensAlias: aws.String(arn),
+	}
+	output, err := conn.GetLens(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lens == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Lens, nil
+}
+
+type lensResourceModel struct {
+	framework.WithRegionModel
+	ARN            types.String         `tfsdk:"arn" autoflex:"name=LensArn"`
+	Description    types.String         `tfsdk:"description"`
+	IsMajorVersion types.Bool           `tfsdk:"is_major_version" autoflex:"-"`
+	JSONString     jsontypes.Normalized `tfsdk:"json_string" autoflex:"-"`
+	LensVersion    types.String         `tfsdk:"lens_version" autoflex:"-"`
+	Name           types.String         `tfsdk:"name"`
+	Owner          types.String         `tfsdk:"owner"`
+	Tags           tftags.Map           `tfsdk:"tags"`
+	TagsAll        tftags.Map           `tfsdk:"tags_all"`
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_profile_share", name="Profile Share")
+// @IdentityAttribute("profile_arn")
+// @IdentityAttribute("share_id")
+// @ImportIDHandler("profileShareImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.ProfileShareSummary")
+// @Testing(importStateIdFunc=testAccProfileShareImportStateIDFunc)
+// @Testing(importStateIdAttribute="share_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(useAlternateAccount=true)
+// @Testing(hasNoPreExistingResource=true)
+// The profile template's question and choice IDs are only available from the API,
+// so generated tests require them to be set in environment variables.
+// @Testing(requireEnvVar="TEST_AWS_WELLARCHITECTED_PROFILE_QUESTION_ID")
+// @Testing(requireEnvVar="TEST_AWS_WELLARCHITECTED_PROFILE_CHOICE_ID")
+func newProfileShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileShareResource{}, nil
+}
+
+type profileShareResource struct {
+	framework.ResourceWithModel[profileShareResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+}
+
+func (r *profileShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"profile_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": framework.IDAttribute(),
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *profileShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	profileARN := fwflex.StringValueFromFramework(ctx, data.ProfileARN)
+	input := wellarchitected.CreateProfileShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ProfileArn:         aws.String(profileARN),
+		SharedWith:         fwflex.StringFromFramework(ctx, data.SharedWith),
+	}
+	output, err := conn.CreateProfileShare(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Profile (%s) Share", profileARN), err.Error())
+
+		return
+	}
+
+	shareID := aws.ToString(output.ShareId)
+	share, err := findProfileShareByTwoPartKey(ctx, conn, profileARN, shareID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s) Share (%s)", profileARN, shareID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, share, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *profileShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	profileARN, shareID := fwflex.StringValueFromFramework(ctx, data.ProfileARN), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	output, err := findProfileShareByTwoPartKey(ctx, conn, profileARN, shareID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s) Share (%s)", profileARN, shareID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	profileARN, shareID := fwflex.StringValueFromFramework(ctx, data.ProfileARN), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	input := wellarchitected.DeleteProfileShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ProfileArn:         aws.String(profileARN),
+		ShareId:            aws.String(shareID),
+	}
+	_, err := conn.DeleteProfileShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Profile (%s) Share (%s)", profileARN, shareID), err.Error())
+
+		return
+	}
+}
+
+func findProfileShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, profileARN, shareID string) (*awstypes.ProfileShareSummary, error) {
+	input := wellarchitected.ListProfileSharesInput{
+		ProfileArn: aws.String(profileARN),
+	}
+	output, err := findProfileShares(ctx, conn, &input, func(v *awstypes.ProfileShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findProfileShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListProfileSharesInput, filter tfslices.Predicate[*awstypes.ProfileShareSummary]) ([]awstypes.ProfileShareSummary, error) {
+	var output []awstypes.ProfileShareSummary
+
+	pages := wellarchitected.NewListProfileSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProfileShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type profileShareResourceModel struct {
+	framework.WithRegionModel
+	ProfileARN fwtypes.ARN                              `tfsdk:"profile_arn" autoflex:"-"`
+	ShareID    types.String                             `tfsdk:"share_id"`
+	SharedWith types.String                             `tfsdk:"shared_with"`
+	Status     fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
+
+var _ inttypes.ImportIDParser = profileShareImportID{}
+
+type profileShareImportID struct{}
+
+func (profileShareImportID) Parse(id string) (string, map[string]string, error) {
+	profileARN, shareID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <profile-arn>"+intflex.ResourceIdSeparator+"<share-id>", id)
+	}
+
+	result := map[string]string{
+		"profile_arn": profileARN,
+		"share_id":    shareID,
+	}
+
+	return id, result, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedProfileShare_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ProfileShareSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile_share.test"
+	questionID, choiceIDs := testAccProfileQuestion(ctx, t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckProfileShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileShareConfig_basic(rName, questionID, choiceIDs[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileShareExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "share_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_with", "data.aws_caller_identity.target", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "PENDING"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_arn", "aws_wellarchitected_profile.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccProfileShareImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "share_id",
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfileShare_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ProfileShareSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile_share.test"
+	questionID, choiceIDs := testAccProfileQuestion(ctx, t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckProfileShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileShareConfig_basic(rName, questionID, choiceIDs[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileShareExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceProfileShare, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProfileShareDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_profile_share" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindProfileShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["profile_arn"], rs.Primary.Attributes["share_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Profile Share %s still exists", rs.Primary.Attributes["share_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProfileShareExists(ctx context.Context, n string, v *awstypes.ProfileShareSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindProfileShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["profile_arn"], rs.Primary.Attributes["share_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfileShareImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		return acctest.AttrsImportStateIdFunc(n, ",", "profile_arn", "share_id")(s)
+	}
+}
+
+func testAccProfileShareConfig_basic(rName, questionID, choiceID string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccProfileConfig_basic(rName, questionID, choiceID), `
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_wellarchitected_profile_share" "test" {
+  profile_arn = aws_wellarchitected_profile.test.arn
+  shared_with = data.aws_caller_identity.target.account_id
+}
+`)
+}
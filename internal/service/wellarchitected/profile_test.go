@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+	questionID, choiceIDs := testAccProfileQuestion(ctx, t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, questionID, choiceIDs[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`profile/.+$`)),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "profile_description", "Test profile"),
+					resource.TestCheckResourceAttr(resourceName, "profile_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "profile_questions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "profile_questions.*", map[string]string{
+						"question_id":           questionID,
+						"selected_choice_ids.#": "1",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "profile_version"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+	questionID, choiceIDs := testAccProfileQuestion(ctx, t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, questionID, choiceIDs[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_profile.test"
+	questionID, choiceIDs := testAccProfileQuestion(ctx, t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, questionID, choiceIDs[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemAttr(resourceName, "profile_questions.*.selected_choice_ids.*", choiceIDs[0]),
+				),
+			},
+			{
+				Config: testAccProfileConfig_updated(rName, questionID, choiceIDs[1]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "profile_description", "Updated profile"),
+					resource.TestCheckResourceAttr(resourceName, "profile_questions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "profile_questions.*.selected_choice_ids.*", choiceIDs[1]),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+// testAccProfileQuestion returns the ID of a profile template question and the IDs of its choices.
+// The question must offer at least two choices so that the selection can be changed.
+func testAccProfileQuestion(ctx context.Context, t *testing.T) (string, []string) {
+	t.Helper()
+
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	acctest.PreCheck(ctx, t)
+	testAccPreCheck(ctx, t)
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	input := wellarchitected.GetProfileTemplateInput{}
+	output, err := conn.GetProfileTemplate(ctx, &input)
+
+	if err != nil {
+		t.Fatalf("reading Well-Architected Profile Template: %s", err)
+	}
+
+	for _, question := range output.ProfileTemplate.TemplateQuestions {
+		if len(question.QuestionChoices) < 2 {
+			continue
+		}
+
+		var choiceIDs []string
+		for _, choice := range question.QuestionChoices {
+			choiceIDs = append(choiceIDs, aws.ToString(choice.ChoiceId))
+		}
+
+		return aws.ToString(question.QuestionId), choiceIDs
+	}
+
+	t.Skip("skipping acceptance testing: no suitable Well-Architected Profile Template question found")
+
+	return "", nil
+}
+
+func testAccCheckProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_profile" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindProfileByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Profile %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProfileExists(ctx context.Context, n string, v *awstypes.Profile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindProfileByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfileConfig_basic(rName, questionID, choiceID string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = "Test profile"
+
+  profile_questions {
+    question_id         = %[2]q
+    selected_choice_ids = [%[3]q]
+  }
+}
+`, rName, questionID, choiceID)
+}
+
+func testAccProfileConfig_updated(rName, questionID, choiceID string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = "Updated profile"
+
+  profile_questions {
+    question_id         = %[2]q
+    selected_choice_ids = [%[3]q]
+  }
+}
+`, rName, questionID, choiceID)
+}
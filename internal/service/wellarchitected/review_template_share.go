@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_review_template_share", name="Review Template Share")
+// @IdentityAttribute("template_arn")
+// @IdentityAttribute("share_id")
+// @ImportIDHandler("reviewTemplateShareImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.TemplateShareSummary")
+// @Testing(importStateIdFunc=testAccReviewTemplateShareImportStateIDFunc)
+// @Testing(importStateIdAttribute="share_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(useAlternateAccount=true)
+// @Testing(hasNoPreExistingResource=true)
+func newReviewTemplateShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &reviewTemplateShareResource{}, nil
+}
+
+type reviewTemplateShareResource struct {
+	framework.ResourceWithModel[reviewTemplateShareResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByIdentity
+}
+
+func (r *reviewTemplateShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"template_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": framework.IDAttribute(),
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *reviewTemplateShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data reviewTemplateShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	templateARN := fwflex.StringValueFromFramework(ctx, data.TemplateARN)
+	input := wellarchitected.CreateTemplateShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		TemplateArn:        aws.String(templateARN),
+		SharedWith:         fwflex.StringFromFramework(ctx, data.SharedWith),
+	}
+	output, err := conn.CreateTemplateShare(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Review Template (%s) Share", templateARN), err.Error())
+
+		return
+	}
+
+	shareID := aws.ToString(output.ShareId)
+	share, err := findReviewTemplateShareByTwoPartKey(ctx, conn, templateARN, shareID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s) Share (%s)", templateARN, shareID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, share, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *reviewTemplateShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data reviewTemplateShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	templateARN, shareID := fwflex.StringValueFromFramework(ctx, data.TemplateARN), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	output, err := findReviewTemplateShareByTwoPartKey(ctx, conn, templateARN, shareID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s) Share (%s)", templateARN, shareID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *reviewTemplateShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data reviewTemplateShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	templateARN, shareID := fwflex.StringValueFromFramework(ctx, data.TemplateARN), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	input := wellarchitected.DeleteTemplateShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		TemplateArn:        aws.String(templateARN),
+		ShareId:            aws.String(shareID),
+	}
+	_, err := conn.DeleteTemplateShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Review Template (%s) Share (%s)", templateARN, shareID), err.Error())
+
+		return
+	}
+}
+
+func findReviewTemplateShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, templateARN, shareID string) (*awstypes.TemplateShareSummary, error) {
+	input := wellarchitected.ListTemplateSharesInput{
+		TemplateArn: aws.String(templateARN),
+	}
+	output, err := findReviewTemplateShares(ctx, conn, &input, func(v *awstypes.TemplateShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReviewTemplateShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListTemplateSharesInput, filter tfslices.Predicate[*awstypes.TemplateShareSummary]) ([]awstypes.TemplateShareSummary, error) {
+	var output []awstypes.TemplateShareSummary
+
+	pages := wellarchitected.NewListTemplateSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TemplateShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type reviewTemplateShareResourceModel struct {
+	framework.WithRegionModel
+	TemplateARN fwtypes.ARN                              `tfsdk:"template_arn" autoflex:"-"`
+	ShareID     types.String                             `tfsdk:"share_id"`
+	SharedWith  types.String                             `tfsdk:"shared_with"`
+	Status      fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
+
+var _ inttypes.ImportIDParser = reviewTemplateShareImportID{}
+
+type reviewTemplateShareImportID struct{}
+
+func (reviewTemplateShareImportID) Parse(id string) (string, map[string]string, error) {
+	templateARN, shareID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <template-arn>"+intflex.ResourceIdSeparator+"<share-id>", id)
+	}
+
+	result := map[string]string{
+		"template_arn": templateARN,
+		"share_id":     shareID,
+	}
+
+	return id, result, nil
+}
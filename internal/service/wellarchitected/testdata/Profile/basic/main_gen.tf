@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = var.rName
+  profile_description = "Test profile"
+
+  profile_questions {
+    question_id         = var.TEST_AWS_WELLARCHITECTED_PROFILE_QUESTION_ID
+    selected_choice_ids = [var.TEST_AWS_WELLARCHITECTED_PROFILE_CHOICE_ID]
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "TEST_AWS_WELLARCHITECTED_PROFILE_QUESTION_ID" {
+  type     = string
+  nullable = false
+}
+
+variable "TEST_AWS_WELLARCHITECTED_PROFILE_CHOICE_ID" {
+  type     = string
+  nullable = false
+}
@@ -0,0 +1,71 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_profile_share" "test" {
+  region = var.region
+
+  profile_arn = aws_wellarchitected_profile.test.arn
+  shared_with = data.aws_caller_identity.target.account_id
+}
+
+resource "aws_wellarchitected_profile" "test" {
+  region = var.region
+
+  profile_name        = var.rName
+  profile_description = "Test profile"
+
+  profile_questions {
+    question_id         = var.TEST_AWS_WELLARCHITECTED_PROFILE_QUESTION_ID
+    selected_choice_ids = [var.TEST_AWS_WELLARCHITECTED_PROFILE_CHOICE_ID]
+  }
+}
+
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+provider "awsalternate" {
+  access_key = var.AWS_ALTERNATE_ACCESS_KEY_ID
+  profile    = var.AWS_ALTERNATE_PROFILE
+  secret_key = var.AWS_ALTERNATE_SECRET_ACCESS_KEY
+}
+
+variable "AWS_ALTERNATE_ACCESS_KEY_ID" {
+  type     = string
+  nullable = true
+  default  = null
+}
+
+variable "AWS_ALTERNATE_PROFILE" {
+  type     = string
+  nullable = true
+  default  = null
+}
+
+variable "AWS_ALTERNATE_SECRET_ACCESS_KEY" {
+  type     = string
+  nullable = true
+  default  = null
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "TEST_AWS_WELLARCHITECTED_PROFILE_QUESTION_ID" {
+  type     = string
+  nullable = false
+}
+
+variable "TEST_AWS_WELLARCHITECTED_PROFILE_CHOICE_ID" {
+  type     = string
+  nullable = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
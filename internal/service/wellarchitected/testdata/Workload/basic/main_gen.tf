@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name   = var.rName
+  description     = "Test workload"
+  environment     = "PREPRODUCTION"
+  lenses          = ["wellarchitected"]
+  non_aws_regions = ["on-premises"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
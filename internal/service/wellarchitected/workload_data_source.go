@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+func newWorkloadDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workloadDataSource{}, nil
+}
+
+type workloadDataSource struct {
+	framework.DataSourceWithModel[workloadDataSourceModel]
+}
+
+func (d *workloadDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Computed:   true,
+			},
+			"improvement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadImprovementStatus](),
+				Computed:   true,
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+			},
+			"prioritized_risk_counts": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"review_owner": schema.StringAttribute{
+				Computed: true,
+			},
+			"risk_counts": schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[types.Int64](ctx),
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"workload_id": schema.StringAttribute{
+				Required: true,
+			},
+			"workload_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *workloadDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workloadDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WellArchitectedClient(ctx)
+
+	workloadID := fwflex.StringValueFromFramework(ctx, data.WorkloadID)
+	output, err := findWorkloadByID(ctx, conn, workloadID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", workloadID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	riskCounts, diags := flattenRiskCounts(ctx, output.RiskCounts)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.RiskCounts = riskCounts
+
+	prioritizedRiskCounts, diags := flattenRiskCounts(ctx, output.PrioritizedRiskCounts)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.PrioritizedRiskCounts = prioritizedRiskCounts
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type workloadDataSourceModel struct {
+	framework.WithRegionModel
+	ARN                   types.String                                           `tfsdk:"arn" autoflex:"name=WorkloadArn"`
+	Description           types.String                                           `tfsdk:"description"`
+	Environment           fwtypes.StringEnum[awstypes.WorkloadEnvironment]       `tfsdk:"environment"`
+	ImprovementStatus     fwtypes.StringEnum[awstypes.WorkloadImprovementStatus] `tfsdk:"improvement_status"`
+	Lenses                fwtypes.SetOfString                                    `tfsdk:"lenses"`
+	Owner                 types.String                                           `tfsdk:"owner"`
+	PrioritizedRiskCounts fwtypes.MapValueOf[types.Int64]                        `tfsdk:"prioritized_risk_counts" autoflex:"-"`
+	ReviewOwner           types.String                                           `tfsdk:"review_owner"`
+	RiskCounts            fwtypes.MapValueOf[types.Int64]                        `tfsdk:"risk_counts" autoflex:"-"`
+	Tags                  tftags.Map                                             `tfsdk:"tags"`
+	WorkloadID            types.String                                           `tfsdk:"workload_id"`
+	WorkloadName          types.String                                           `tfsdk:"workload_name"`
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload_share", name="Workload Share")
+// @IdentityAttribute("workload_id")
+// @IdentityAttribute("share_id")
+// @ImportIDHandler("workloadShareImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.WorkloadShareSummary")
+// @Testing(importStateIdFunc=testAccWorkloadShareImportStateIDFunc)
+// @Testing(importStateIdAttribute="share_id")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(useAlternateAccount=true)
+// @Testing(hasNoPreExistingResource=true)
+func newWorkloadShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &workloadShareResource{}, nil
+}
+
+type workloadShareResource struct {
+	framework.ResourceWithModel[workloadShareResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *workloadShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"permission_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PermissionType](),
+				Required:   true,
+			},
+			"share_id": framework.IDAttribute(),
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID := fwflex.StringValueFromFramework(ctx, data.WorkloadID)
+	var input wellarchitected.CreateWorkloadShareInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.CreateWorkloadShare(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) Share", workloadID), err.Error())
+
+		return
+	}
+
+	shareID := aws.ToString(output.ShareId)
+	share, err := findWorkloadShareByTwoPartKey(ctx, conn, workloadID, shareID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s) Share (%s)", workloadID, shareID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, share, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *workloadShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID, shareID := fwflex.StringValueFromFramework(ctx, data.WorkloadID), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	output, err := findWorkloadShareByTwoPartKey(ctx, conn, workloadID, shareID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s) Share (%s)", workloadID, shareID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadShareResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old workloadShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID, shareID := fwflex.StringValueFromFramework(ctx, new.WorkloadID), fwflex.StringValueFromFramework(ctx, new.ShareID)
+
+	if !new.PermissionType.Equal(old.PermissionType) {
+		input := wellarchitected.UpdateWorkloadShareInput{
+			PermissionType: new.PermissionType.ValueEnum(),
+			ShareId:        aws.String(shareID),
+			WorkloadId:     aws.String(workloadID),
+		}
+		_, err := conn.UpdateWorkloadShare(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload (%s) Share (%s)", workloadID, shareID), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findWorkloadShareByTwoPartKey(ctx, conn, workloadID, shareID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s) Share (%s)", workloadID, shareID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workloadShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	workloadID, shareID := fwflex.StringValueFromFramework(ctx, data.WorkloadID), fwflex.StringValueFromFramework(ctx, data.ShareID)
+	input := wellarchitected.DeleteWorkloadShareInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ShareId:            aws.String(shareID),
+		WorkloadId:         aws.String(workloadID),
+	}
+	_, err := conn.DeleteWorkloadShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload (%s) Share (%s)", workloadID, shareID), err.Error())
+
+		return
+	}
+}
+
+func findWorkloadShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, workloadID, shareID string) (*awstypes.WorkloadShareSummary, error) {
+	input := wellarchitected.ListWorkloadSharesInput{
+		WorkloadId: aws.String(workloadID),
+	}
+	output, err := findWorkloadShares(ctx, conn, &input, func(v *awstypes.WorkloadShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findWorkloadShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListWorkloadSharesInput, filter tfslices.Predicate[*awstypes.WorkloadShareSummary]) ([]awstypes.WorkloadShareSummary, error) {
+	var output []awstypes.WorkloadShareSummary
+
+	pages := wellarchitected.NewListWorkloadSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.WorkloadShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type workloadShareResourceModel struct {
+	framework.WithRegionModel
+	PermissionType fwtypes.StringEnum[awstypes.PermissionType] `tfsdk:"permission_type"`
+	ShareID        types.String                                `tfsdk:"share_id"`
+	SharedWith     types.String                                `tfsdk:"shared_with"`
+	Status         fwtypes.StringEnum[awstypes.ShareStatus]    `tfsdk:"status"`
+	WorkloadID     types.String                                `tfsdk:"workload_id"`
+}
+
+var _ inttypes.ImportIDParser = workloadShareImportID{}
+
+type workloadShareImportID struct{}
+
+func (workloadShareImportID) Parse(id string) (string, map[string]string, error) {
+	workloadID, shareID, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <workload-id>"+intflex.ResourceIdSeparator+"<share-id>", id)
+	}
+
+	result := map[string]string{
+		"workload_id": workloadID,
+		"share_id":    shareID,
+	}
+
+	return id, result, nil
+}
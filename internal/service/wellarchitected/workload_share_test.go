@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkloadShare_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkloadShareSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckWorkloadShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadShareConfig_basic(rName, "READONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadShareExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", "READONLY"),
+					resource.TestCheckResourceAttrSet(resourceName, "share_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_with", "data.aws_caller_identity.target", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "PENDING"),
+					resource.TestCheckResourceAttrPair(resourceName, "workload_id", "aws_wellarchitected_workload.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccWorkloadShareImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "share_id",
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkloadShare_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkloadShareSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckWorkloadShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadShareConfig_basic(rName, "READONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadShareExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceWorkloadShare, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkloadShare_permissionType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkloadShareSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckWorkloadShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadShareConfig_basic(rName, "READONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadShareExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", "READONLY"),
+				),
+			},
+			{
+				Config: testAccWorkloadShareConfig_basic(rName, "CONTRIBUTOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadShareExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", "CONTRIBUTOR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadShareDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_workload_share" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindWorkloadShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["share_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Workload Share %s still exists", rs.Primary.Attributes["share_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadShareExists(ctx context.Context, n string, v *awstypes.WorkloadShareSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindWorkloadShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["share_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkloadShareImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		return acctest.AttrsImportStateIdFunc(n, ",", "workload_id", "share_id")(s)
+	}
+}
+
+func testAccWorkloadShareConfig_basic(rName, permissionType string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccWorkloadConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_wellarchitected_workload_share" "test" {
+  workload_id     = aws_wellarchitected_workload.test.id
+  shared_with     = data.aws_caller_identity.target.account_id
+  permission_type = %[1]q
+}
+`, permissionType))
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package action
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+)
+
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+
+	td := TemplateData{
+		Action:               "StartWidget",
+		ActionLower:          "startWidget",
+		ActionSnake:          "start_widget",
+		HumanFriendlyService: "Widget",
+		SDKPackage:           "widget",
+		ServicePackage:       "widget",
+		Service:              "Widget",
+		ServiceLower:         "widget",
+		HumanActionName:      "Start Widget",
+		ProviderResourceName: "aws_widget_start_widget",
+	}
+
+	for _, comments := range []bool{true, false} {
+		td.IncludeComments = comments
+
+		for name, tmpl := range map[string]string{"action": actionTmpl, "actiontest": actionTestTmpl} {
+			b, err := renderTemplate(name, tmpl, td)
+			if err != nil {
+				t.Fatalf("rendering %s: %s", name, err)
+			}
+
+			if _, err := format.Source(b); err != nil {
+				t.Errorf("formatting %s (comments=%t): %s\n%s", name, comments, err, b)
+			}
+		}
+
+		b, err := renderTemplate("webdoc", websiteTmpl, td)
+		if err != nil {
+			t.Fatalf("rendering webdoc: %s", err)
+		}
+
+		if want := `action "aws_widget_start_widget" "example"`; !strings.Contains(string(b), want) {
+			t.Errorf("%q not found in\n%s", want, b)
+		}
+	}
+}
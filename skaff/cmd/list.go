@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/list"
+	"github.com/spf13/cobra"
+)
+
+var fromResource string
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Create scaffolding for a list resource",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return list.Create(name, snakeName, fromResource, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+	listCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	listCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	listCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
+	listCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	listCmd.Flags().StringVar(&fromResource, "from-resource", "", "existing Plugin Framework resource to list (e.g., aws_pcs_queue, or queue in internal/service/pcs); the list schema is derived from its resource identity")
+}
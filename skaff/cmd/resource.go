@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/model"
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -15,13 +16,14 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	operations    model.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags, operations)
 	},
 }
 
@@ -33,4 +35,9 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-op", "", "AWS API create operation (e.g., CreateQueue); generates code from the AWS SDK for Go v2 shapes of the operations")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-op", "", "AWS API read operation (e.g., GetQueue)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-op", "", "AWS API update operation (e.g., UpdateQueue); omit if the resource cannot be updated")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-op", "", "AWS API delete operation (e.g., DeleteQueue)")
+	resourceCmd.Flags().StringVar(&operations.List, "list-op", "", "AWS API list operation used by the sweeper (e.g., ListQueues)")
 }
@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/model"
+)
+
+//go:embed list.gtpl
+var listTmpl string
+
+//go:embed listtest.gtpl
+var listTestTmpl string
+
+//go:embed websitedoc.gtpl
+var websiteTmpl string
+
+type TemplateData struct {
+	ListResource          string
+	ListResourceLower     string
+	ListResourceSnake     string
+	IncludeComments       bool
+	HumanFriendlyService  string
+	SDKPackage            string
+	ServicePackage        string
+	Service               string
+	ServiceLower          string
+	AWSServiceName        string
+	HumanListResourceName string
+	ProviderResourceName  string
+	FromResource          bool
+	Resource              *Resource
+}
+
+// Resource describes an existing Plugin Framework resource and its identity.
+type Resource struct {
+	Struct             string               // e.g. queueResource
+	Model              string               // e.g. queueResourceModel
+	ARNIdentity        string               // Name of the ARN identity attribute, if ARN identity is used.
+	Singleton          bool                 // Whether singleton identity is used.
+	IdentityAttributes []*IdentityAttribute // Identity attributes, if parameterized identity is used.
+}
+
+type IdentityAttribute struct {
+	Name      string // e.g. vpc_id
+	FieldName string // e.g. VPCID
+	Optional  bool
+}
+
+// ConfigAttributes returns the identity attributes that scope the listing, i.e. all but the last.
+// The last identity attribute identifies each listed resource.
+func (r *Resource) ConfigAttributes() []*IdentityAttribute {
+	if n := len(r.IdentityAttributes); n > 1 {
+		return r.IdentityAttributes[:n-1]
+	}
+
+	return nil
+}
+
+func Create(listName, snakeName, fromResource string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if listName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if listName == strings.ToLower(listName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(listName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	templateData := TemplateData{
+		ListResource:          listName,
+		ListResourceLower:     strings.ToLower(listName),
+		ListResourceSnake:     snakeName,
+		HumanFriendlyService:  service.HumanFriendly(),
+		IncludeComments:       comments,
+		SDKPackage:            service.GoV2Package(),
+		ServicePackage:        servicePackage,
+		Service:               service.ProviderNameUpper(),
+		ServiceLower:          strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:        service.FullHumanFriendly(),
+		HumanListResourceName: convert.ToHumanResName(listName),
+		ProviderResourceName:  convert.ToProviderResourceName(servicePackage, snakeName),
+		// Defaults match the resource scaffolded by `skaff resource`.
+		Resource: &Resource{
+			Struct:      "resource" + listName,
+			Model:       "resource" + listName + "Model",
+			ARNIdentity: names.AttrARN,
+		},
+	}
+
+	if fromResource != "" {
+		if !strings.HasPrefix(fromResource, "aws_") {
+			fromResource = convert.ToProviderResourceName(servicePackage, fromResource)
+		}
+
+		r, err := loadResource(wd, fromResource)
+		if err != nil {
+			return fmt.Errorf("loading resource %s: %w", fromResource, err)
+		}
+
+		templateData.FromResource = true
+		templateData.Resource = r
+		templateData.ProviderResourceName = fromResource
+	}
+
+	f := fmt.Sprintf("%s_list.go", snakeName)
+	if err = writeTemplate("newlist", f, listTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_list_test.go", snakeName)
+	if err = writeTemplate("listtest", tf, listTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "list-resources", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+var (
+	// nosemgrep:ci.calling-regexp.MustCompile-directly
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+	// nosemgrep:ci.calling-regexp.MustCompile-directly
+	withModel = regexache.MustCompile(`^framework\.ResourceWithModel\[(\w+)\]$`)
+)
+
+// loadResource reads the identity of the Plugin Framework resource with the specified type name
+// from the annotations of its factory function in the service package source in dir.
+func loadResource(dir, typeName string) (*Resource, error) {
+	files, err := parseFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil {
+				continue
+			}
+
+			r, found, err := resourceFromAnnotations(fn, typeName)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+
+			r.Struct = factoryStruct(fn)
+			if r.Struct == "" {
+				return nil, fmt.Errorf("resource type not found in %s", fn.Name.Name)
+			}
+			r.Model = resourceModel(files, r.Struct)
+
+			fields := modelFields(files, r.Model)
+			for _, v := range r.IdentityAttributes {
+				if name, ok := fields[v.Name]; ok {
+					v.FieldName = name
+				}
+			}
+
+			return r, nil
+		}
+	}
+
+	return nil, errors.New("@FrameworkResource annotation not found")
+}
+
+func parseFiles(dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+func resourceFromAnnotations(fn *ast.FuncDecl, typeName string) (*Resource, bool, error) {
+	var r Resource
+	found := false
+
+	for _, line := range fn.Doc.List {
+		m := annotation.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		args := parseArgs(m[3])
+
+		switch m[1] {
+		case "FrameworkResource":
+			found = len(args.Positional) > 0 && args.Positional[0] == typeName
+
+		case "SDKResource":
+			if len(args.Positional) > 0 && args.Positional[0] == typeName {
+				return nil, false, errors.New("only Plugin Framework resources are supported")
+			}
+
+		case "ArnIdentity":
+			r.ARNIdentity = names.AttrARN
+			if len(args.Positional) > 0 {
+				r.ARNIdentity = args.Positional[0]
+			}
+
+		case "SingletonIdentity":
+			r.Singleton = true
+
+		case "IdentityAttribute":
+			if len(args.Positional) == 0 {
+				return nil, false, errors.New("no Identity attribute name")
+			}
+			r.IdentityAttributes = append(r.IdentityAttributes, &IdentityAttribute{
+				Name:      args.Positional[0],
+				FieldName: model.FieldName(args.Positional[0]),
+				Optional:  args.Keyword["optional"] == "true",
+			})
+		}
+	}
+
+	if found && r.ARNIdentity == "" && !r.Singleton && len(r.IdentityAttributes) == 0 {
+		return nil, false, errors.New("resource has no identity")
+	}
+
+	return &r, found, nil
+}
+
+type annotationArgs struct {
+	Positional []string
+	Keyword    map[string]string
+}
+
+// parseArgs parses an annotation's argument list of the form `"positional0", keywordA="valueA"`.
+// It is a subset of the service package generator's parser, without its dependencies.
+func parseArgs(s string) annotationArgs {
+	args := annotationArgs{
+		Keyword: make(map[string]string),
+	}
+
+	for _, arg := range strings.Split(s, ",") {
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(arg, "=")
+		key, value = strings.Trim(key, `"`), strings.Trim(value, `"`)
+		if value == "" {
+			args.Positional = append(args.Positional, key)
+		} else {
+			args.Keyword[key] = value
+		}
+	}
+
+	return args
+}
+
+// factoryStruct returns the name of the resource struct instantiated by a resource factory function,
+// e.g. queueResource for `r := &queueResource{}`.
+func factoryStruct(fn *ast.FuncDecl) string {
+	var name string
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if v, ok := n.(*ast.UnaryExpr); ok && v.Op == token.AND {
+			if v, ok := v.X.(*ast.CompositeLit); ok {
+				if v, ok := v.Type.(*ast.Ident); ok {
+					name = v.Name
+				}
+			}
+		}
+		return name == ""
+	})
+
+	return name
+}
+
+// resourceModel returns the name of the model type of a resource struct embedding framework.ResourceWithModel.
+func resourceModel(files []*ast.File, structName string) string {
+	if st := structType(files, structName); st != nil {
+		var buf bytes.Buffer
+
+		for _, field := range st.Fields.List {
+			if len(field.Names) > 0 {
+				continue
+			}
+
+			buf.Reset()
+			if err := format.Node(&buf, token.NewFileSet(), field.Type); err != nil {
+				continue
+			}
+			if m := withModel.FindStringSubmatch(buf.String()); len(m) > 0 {
+				return m[1]
+			}
+		}
+	}
+
+	return structName + "Model"
+}
+
+// modelFields returns the field names of a model struct keyed by their `tfsdk` tag.
+func modelFields(files []*ast.File, structName string) map[string]string {
+	fields := make(map[string]string)
+
+	if st := structType(files, structName); st != nil {
+		for _, field := range st.Fields.List {
+			if len(field.Names) == 0 || field.Tag == nil {
+				continue
+			}
+
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+			if name, ok := reflect.StructTag(tag).Lookup("tfsdk"); ok {
+				fields[name] = field.Names[0].Name
+			}
+		}
+	}
+
+	return fields
+}
+
+func structType(files []*ast.File, name string) *ast.StructType {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				if st, ok := spec.Type.(*ast.StructType); ok && spec.Name.Name == name {
+					return st
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	// The website docs directory for list resources may not exist yet.
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return fmt.Errorf("error creating directory for file (%s): %s", filename, err)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	b, err := renderTemplate(templateName, tmpl, td)
+	if err != nil {
+		f.Close() // ignore error; render error takes precedence
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func renderTemplate(templateName, tmpl string, td TemplateData) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"go/format"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		TypeName string
+		Expected *Resource
+		Error    string
+	}{
+		{
+			TestName: "parameterized identity",
+			TypeName: "aws_widget_attachment",
+			Expected: &Resource{
+				Struct: "attachmentResource",
+				Model:  "attachmentResourceModel",
+				IdentityAttributes: []*IdentityAttribute{
+					{Name: "widget_id", FieldName: "ParentID"},
+					{Name: "attachment_id", FieldName: "AttachmentID"},
+				},
+			},
+		},
+		{
+			TestName: "ARN identity",
+			TypeName: "aws_widget_gadget",
+			Expected: &Resource{
+				Struct:      "gadgetResource",
+				Model:       "gadgetResourceModel",
+				ARNIdentity: "arn",
+			},
+		},
+		{
+			TestName: "SDK resource",
+			TypeName: "aws_widget_legacy",
+			Error:    "only Plugin Framework resources are supported",
+		},
+		{
+			TestName: "no identity",
+			TypeName: "aws_widget_anonymous",
+			Error:    "resource has no identity",
+		},
+		{
+			TestName: "not found",
+			TypeName: "aws_widget_missing",
+			Error:    "annotation not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := loadResource("testdata/widget", testCase.TypeName)
+
+			if testCase.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.Error) {
+					t.Fatalf("expected error containing %q, got %v", testCase.Error, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("loading: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigAttributes(t *testing.T) {
+	t.Parallel()
+
+	r, err := loadResource("testdata/widget", "aws_widget_attachment")
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	got := r.ConfigAttributes()
+	if len(got) != 1 || got[0].Name != "widget_id" {
+		t.Errorf("unexpected config attributes: %+v", got)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+
+	attachment, err := loadResource("testdata/widget", "aws_widget_attachment")
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	td := TemplateData{
+		ListResource:          "Attachment",
+		ListResourceLower:     "attachment",
+		ListResourceSnake:     "attachment",
+		HumanFriendlyService:  "Widget",
+		SDKPackage:            "widget",
+		ServicePackage:        "widget",
+		Service:               "Widget",
+		ServiceLower:          "widget",
+		HumanListResourceName: "Attachment",
+		ProviderResourceName:  "aws_widget_attachment",
+		FromResource:          true,
+		Resource:              attachment,
+	}
+
+	for _, comments := range []bool{true, false} {
+		td.IncludeComments = comments
+
+		for name, tmpl := range map[string]string{"list": listTmpl, "listtest": listTestTmpl} {
+			b, err := renderTemplate(name, tmpl, td)
+			if err != nil {
+				t.Fatalf("rendering %s: %s", name, err)
+			}
+
+			if _, err := format.Source(b); err != nil {
+				t.Errorf("formatting %s (comments=%t): %s\n%s", name, comments, err, b)
+			}
+		}
+
+		b, err := renderTemplate("webdoc", websiteTmpl, td)
+		if err != nil {
+			t.Fatalf("rendering webdoc: %s", err)
+		}
+
+		if want := "    widget_id = \"example\""; !strings.Contains(string(b), want) {
+			t.Errorf("%q not found in\n%s", want, b)
+		}
+	}
+}
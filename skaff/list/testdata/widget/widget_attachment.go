@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkResource("aws_widget_attachment", name="Attachment")
+// @IdentityAttribute("widget_id")
+// @IdentityAttribute("attachment_id")
+func newAttachmentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &attachmentResource{}
+
+	return r, nil
+}
+
+type attachmentResource struct {
+	framework.ResourceWithModel[attachmentResourceModel]
+	framework.WithNoUpdate
+}
+
+type attachmentResourceModel struct {
+	framework.WithRegionModel
+	AttachmentID types.String `tfsdk:"attachment_id"`
+	ParentID     types.String `tfsdk:"widget_id"`
+}
+
+// @FrameworkResource("aws_widget_gadget", name="Gadget")
+// @ArnIdentity(identityDuplicateAttributes="id")
+func newGadgetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &gadgetResource{}, nil
+}
+
+type gadgetResource struct {
+	framework.ResourceWithConfigure
+}
+
+// @SDKResource("aws_widget_legacy", name="Legacy")
+// @IdentityAttribute("name")
+func resourceLegacy() {}
+
+// @FrameworkResource("aws_widget_anonymous", name="Anonymous")
+func newAnonymousResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &anonymousResource{}, nil
+}
+
+type anonymousResource struct {
+	framework.ResourceWithConfigure
+}
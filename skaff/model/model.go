@@ -0,0 +1,894 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package model derives the schema and data structures of a Terraform Plugin Framework resource
+// from the shapes of the AWS SDK for Go v2 operations that manage it.
+package model
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	sdkServicePath   = "github.com/aws/aws-sdk-go-v2/service/"
+	namesPackagePath = "github.com/hashicorp/terraform-provider-aws/names"
+
+	requiredMemberDoc = "This member is required."
+)
+
+// Operations are the names of the AWS API operations that manage a resource, e.g. CreateQueue.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// Resource is the model of a resource derived from its operations' input and output shapes.
+type Resource struct {
+	Name       string // e.g. Queue.
+	SDKPackage string // e.g. pcs.
+	Operations Operations
+
+	// Attributes are the resource's top-level attributes and blocks, sorted by Terraform name.
+	Attributes []*Attribute
+	// Structs are the nested object models, sorted by name.
+	Structs []*Struct
+	// Tags is set if the create operation's input has a Tags field.
+	Tags bool
+
+	// Object is the type returned by the finder, e.g. awstypes.Queue.
+	Object string
+	// CreateOutputField, ReadOutputField and UpdateOutputField are the names of the operations' output fields
+	// holding the resource object, e.g. Queue. They are empty if the output itself holds the resource's fields.
+	CreateOutputField string
+	ReadOutputField   string
+	UpdateOutputField string
+
+	// Identifiers are the read operation's required input fields, which are the finder's parameters.
+	Identifiers []*Identifier
+	// DeleteIdentifiers are the delete operation's required input fields.
+	DeleteIdentifiers []*Identifier
+	// UpdateIdentifiers are the update operation's required input fields not set by AutoFlex.
+	UpdateIdentifiers []*Identifier
+
+	// NotFoundException is the name of the service's not found error type, e.g. ResourceNotFoundException.
+	NotFoundException string
+
+	// Status is nil if the resource object has no status field of an enum type.
+	Status *Status
+	// Sweeper is nil if no list operation was given.
+	Sweeper *Sweeper
+}
+
+// Attribute is a resource or nested object attribute.
+type Attribute struct {
+	Name      string // Terraform name, e.g. compute_node_group_configurations.
+	Key       string // Schema map key, e.g. names.AttrName or "compute_node_group_configurations".
+	FieldName string // Model field name, e.g. ComputeNodeGroupConfigurations.
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+
+	kind     kind
+	elem     string  // Enum type name of kindEnum and kindEnumList attributes.
+	nested   *Struct // Nested object of kindStruct attributes.
+	list     bool    // kindStruct attributes are lists of nested objects, rather than a single object.
+	goType   string  // SDK type of kindUnsupported attributes.
+	sdkNames []string
+	// updatable is set if the update operation's input has a field for the attribute.
+	updatable bool
+}
+
+// Struct is a nested object model.
+type Struct struct {
+	Name       string // e.g. computeNodeGroupConfigurationModel.
+	Attributes []*Attribute
+}
+
+// Identifier is a required input field of an operation and the attribute holding its value.
+type Identifier struct {
+	Field     string // e.g. QueueIdentifier.
+	Param     string // e.g. queueID.
+	Attribute *Attribute
+	Pointer   bool // The field is a *string.
+}
+
+// Status is the resource object's status field and the classification of its values.
+type Status struct {
+	Field   string // e.g. Status.
+	Enum    string // e.g. QueueStatus.
+	Pointer bool
+
+	// Constant names of the status values, e.g. QueueStatusCreating.
+	Creating []string
+	Updating []string
+	Deleting []string
+	Target   []string
+}
+
+// Sweeper describes how the list operation is used to sweep resources.
+type Sweeper struct {
+	Paginator  bool     // The service package has a paginator for the list operation.
+	ItemsField string   // e.g. Queues.
+	Required   []string // Required list operation input fields, which must be set by hand.
+	Attributes []*SweepAttribute
+}
+
+// SweepAttribute maps a field of a list item to the attribute set when sweeping the item.
+type SweepAttribute struct {
+	Key     string // e.g. names.AttrID.
+	Field   string // e.g. Id. Empty if the item has no corresponding field.
+	Pointer bool
+}
+
+type kind int
+
+const (
+	kindUnsupported kind = iota
+	kindString
+	kindEnum
+	kindBool
+	kindInt32
+	kindInt64
+	kindFloat32
+	kindFloat64
+	kindTime
+	kindStringList
+	kindEnumList
+	kindStringMap
+	kindStruct
+)
+
+// Fields which are never modeled.
+var skippedFields = []string{
+	"ClientToken",
+	"DryRun",
+	"MaxResults",
+	"NextToken",
+	"ResultMetadata",
+	"Tags",
+}
+
+// Status values, compared case-insensitively with underscores removed.
+var (
+	creatingStatuses = []string{"creating", "pending", "provisioning", "inprogress", "starting", "initializing"}
+	updatingStatuses = []string{"updating", "modifying"}
+	deletingStatuses = []string{"deleting"}
+	targetStatuses   = []string{"active", "available", "ready", "created", "running", "succeeded", "enabled", "completed", "inservice", "deployed"}
+)
+
+// Load loads the AWS SDK for Go v2 package of a service and derives the model of a resource from its operations.
+// dir is the directory of a package in a module requiring the SDK package, usually the provider's service package.
+func Load(dir, sdkPackage, resourceName string, ops Operations) (*Resource, error) {
+	return load(dir, sdkServicePath+sdkPackage, resourceName, ops)
+}
+
+func load(dir, servicePath, resourceName string, ops Operations) (*Resource, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, errors.New("create, read and delete operations are required")
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, servicePath, servicePath+"/types", namesPackagePath)
+	if err != nil {
+		return nil, fmt.Errorf("loading packages: %w", err)
+	}
+
+	l := &loader{
+		required:   make(map[string]bool),
+		attrConsts: make(map[string]string),
+		structs:    make(map[string]*Struct),
+		visiting:   make(map[string]bool),
+	}
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("loading %s: %w", pkg.PkgPath, pkg.Errors[0])
+		}
+
+		switch pkg.PkgPath {
+		case servicePath:
+			l.service = pkg
+		case servicePath + "/types":
+			l.types = pkg
+		case namesPackagePath:
+			l.names = pkg
+		}
+	}
+
+	if l.service == nil || l.types == nil || l.names == nil {
+		return nil, fmt.Errorf("loading %s: package not found", servicePath)
+	}
+
+	l.readDocs()
+	l.readAttrConsts()
+
+	return l.resource(resourceName, ops)
+}
+
+type loader struct {
+	service *packages.Package
+	types   *packages.Package
+	names   *packages.Package
+
+	// required is the set of required fields, keyed by <type>.<field>.
+	required map[string]bool
+	// attrConsts maps Terraform names to names.Attr constant names.
+	attrConsts map[string]string
+	// structs are the nested object models, keyed by SDK type name.
+	structs  map[string]*Struct
+	visiting map[string]bool
+
+	prefix string
+}
+
+// readDocs records the fields documented as required.
+func (l *loader) readDocs() {
+	for _, pkg := range []*packages.Package{l.service, l.types} {
+		for _, f := range pkg.Syntax {
+			ast.Inspect(f, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+
+				st, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+
+				for _, field := range st.Fields.List {
+					if field.Doc == nil || !strings.Contains(field.Doc.Text(), requiredMemberDoc) {
+						continue
+					}
+					for _, name := range field.Names {
+						l.required[spec.Name.Name+"."+name.Name] = true
+					}
+				}
+
+				return false
+			})
+		}
+	}
+}
+
+func (l *loader) readAttrConsts() {
+	scope := l.names.Types.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !strings.HasPrefix(name, "Attr") || c.Val().Kind() != constant.String {
+			continue
+		}
+		l.attrConsts[constant.StringVal(c.Val())] = name
+	}
+}
+
+func (l *loader) resource(name string, ops Operations) (*Resource, error) {
+	r := &Resource{
+		Name:       name,
+		SDKPackage: l.service.Name,
+		Operations: ops,
+	}
+	l.prefix = name
+
+	createInput, err := l.operationStruct(ops.Create, "Input")
+	if err != nil {
+		return nil, err
+	}
+	createOutput, err := l.operationStruct(ops.Create, "Output")
+	if err != nil {
+		return nil, err
+	}
+	readInput, err := l.operationStruct(ops.Read, "Input")
+	if err != nil {
+		return nil, err
+	}
+	readOutput, err := l.operationStruct(ops.Read, "Output")
+	if err != nil {
+		return nil, err
+	}
+	deleteInput, err := l.operationStruct(ops.Delete, "Input")
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]*Attribute)
+
+	// Arguments.
+	for field := range fields(createInput) {
+		if field.Name() == "Tags" {
+			r.Tags = true
+		}
+		if skipped(field) {
+			continue
+		}
+
+		a := l.attribute(field)
+		a.Required = l.required[ops.Create+"Input."+field.Name()]
+		a.Optional = !a.Required
+		attributes[a.Name] = a
+	}
+
+	// Computed attributes.
+	var object *types.Struct
+	r.ReadOutputField, object = l.objectField(readOutput)
+	objectName := ops.Read + "Output"
+	r.Object = r.SDKPackage + "." + objectName
+	if r.ReadOutputField != "" {
+		objectName = l.typeName(fieldType(readOutput, r.ReadOutputField))
+		r.Object = "awstypes." + objectName
+	} else {
+		object = readOutput
+	}
+
+	for field := range fields(object) {
+		if skipped(field) {
+			continue
+		}
+
+		a := l.attribute(field)
+		if v, ok := attributes[a.Name]; ok {
+			v.sdkNames = appendUnique(v.sdkNames, a.sdkNames...)
+			continue
+		}
+		a.Computed = true
+		attributes[a.Name] = a
+	}
+
+	r.CreateOutputField, _ = l.objectField(createOutput)
+
+	// Required read input fields identify the resource.
+	for field := range fields(readInput) {
+		if l.required[ops.Read+"Input."+field.Name()] {
+			r.Identifiers = append(r.Identifiers, l.identifier(field, attributes))
+		}
+	}
+
+	if ops.Update != "" {
+		updateInput, err := l.operationStruct(ops.Update, "Input")
+		if err != nil {
+			return nil, err
+		}
+		updateOutput, err := l.operationStruct(ops.Update, "Output")
+		if err != nil {
+			return nil, err
+		}
+		r.UpdateOutputField, _ = l.objectField(updateOutput)
+
+		for field := range fields(updateInput) {
+			if skipped(field) {
+				continue
+			}
+
+			if l.required[ops.Update+"Input."+field.Name()] && slices.ContainsFunc(r.Identifiers, func(v *Identifier) bool { return v.Field == field.Name() }) {
+				// Identifiers are set explicitly unless AutoFlex matches them.
+				id := l.identifier(field, attributes)
+				if !strings.EqualFold(id.Attribute.FieldName, field.Name()) && !strings.EqualFold(name+id.Attribute.FieldName, field.Name()) {
+					r.UpdateIdentifiers = append(r.UpdateIdentifiers, id)
+				}
+				continue
+			}
+
+			if a, ok := attributes[l.tfName(field.Name())]; ok {
+				a.updatable = true
+			}
+		}
+	}
+
+	for field := range fields(deleteInput) {
+		if l.required[ops.Delete+"Input."+field.Name()] {
+			r.DeleteIdentifiers = append(r.DeleteIdentifiers, l.identifier(field, attributes))
+		}
+	}
+
+	for _, a := range attributes {
+		if !a.Computed && !a.updatable {
+			a.RequiresReplace = true
+		}
+		r.Attributes = append(r.Attributes, a)
+	}
+	sortAttributes(r.Attributes)
+
+	for _, s := range l.structs {
+		r.Structs = append(r.Structs, s)
+	}
+	slices.SortFunc(r.Structs, func(a, b *Struct) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	r.NotFoundException = l.notFoundException(name)
+	r.Status = l.status(object)
+
+	if ops.List != "" {
+		if r.Sweeper, err = l.sweeper(ops.List, r.Identifiers); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// operationStruct returns the input or output struct of an operation.
+func (l *loader) operationStruct(op, suffix string) (*types.Struct, error) {
+	obj := l.service.Types.Scope().Lookup(op + suffix)
+	if obj == nil {
+		return nil, fmt.Errorf("operation %s not found in %s", op, l.service.PkgPath)
+	}
+
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("%s%s is not a struct", op, suffix)
+	}
+
+	return st, nil
+}
+
+// objectField returns the field of an operation's output holding the resource object.
+// A field named after the resource is preferred, otherwise the output's only pointer to a struct is used.
+func (l *loader) objectField(output *types.Struct) (string, *types.Struct) {
+	var candidates []*types.Var
+	for field := range fields(output) {
+		ptr, ok := field.Type().(*types.Pointer)
+		if !ok {
+			continue
+		}
+		if _, ok := ptr.Elem().Underlying().(*types.Struct); !ok || !l.inTypes(ptr.Elem()) {
+			continue
+		}
+		if field.Name() == l.prefix {
+			candidates = []*types.Var{field}
+			break
+		}
+		candidates = append(candidates, field)
+	}
+
+	if len(candidates) != 1 {
+		return "", nil
+	}
+
+	return candidates[0].Name(), candidates[0].Type().(*types.Pointer).Elem().Underlying().(*types.Struct)
+}
+
+// attribute returns the attribute modeling a struct field.
+func (l *loader) attribute(field *types.Var) *Attribute {
+	a := &Attribute{
+		Name:     l.tfName(field.Name()),
+		sdkNames: []string{field.Name()},
+	}
+	a.Key = l.key(a.Name)
+	a.FieldName = FieldName(a.Name)
+
+	t := field.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	switch t := t.(type) {
+	case *types.Basic:
+		a.kind = basicKind(t)
+	case *types.Named:
+		switch {
+		case t.Obj().Pkg() != nil && t.Obj().Pkg().Path() == "time" && t.Obj().Name() == "Time":
+			a.kind = kindTime
+		case l.isEnum(t):
+			a.kind = kindEnum
+			a.elem = t.Obj().Name()
+		case l.isStruct(t):
+			a.kind = kindStruct
+			a.nested = l.nested(t)
+		}
+	case *types.Slice:
+		switch elem := t.Elem().(type) {
+		case *types.Basic:
+			if elem.Kind() == types.String {
+				a.kind = kindStringList
+			}
+		case *types.Named:
+			switch {
+			case l.isEnum(elem):
+				a.kind = kindEnumList
+				a.elem = elem.Obj().Name()
+			case l.isStruct(elem):
+				a.kind = kindStruct
+				a.list = true
+				a.nested = l.nested(elem)
+			}
+		}
+	case *types.Map:
+		if isString(t.Key()) && isString(t.Elem()) {
+			a.kind = kindStringMap
+		}
+	}
+
+	if a.kind == kindUnsupported || a.kind == kindStruct && a.nested == nil {
+		a.kind = kindUnsupported
+		a.goType = types.TypeString(field.Type(), func(p *types.Package) string { return p.Name() })
+	}
+
+	return a
+}
+
+// nested returns the model of a nested object, or nil for recursive types.
+func (l *loader) nested(t *types.Named) *Struct {
+	name := t.Obj().Name()
+	if s, ok := l.structs[name]; ok {
+		return s
+	}
+	if l.visiting[name] {
+		return nil
+	}
+	l.visiting[name] = true
+	defer delete(l.visiting, name)
+
+	s := &Struct{
+		Name: convert.ToLowercasePrefix(name) + "Model",
+	}
+
+	// Field names of nested objects are not prefixed.
+	prefix := l.prefix
+	l.prefix = ""
+	defer func() { l.prefix = prefix }()
+
+	for field := range fields(t.Underlying().(*types.Struct)) {
+		if skipped(field) {
+			continue
+		}
+
+		a := l.attribute(field)
+		a.Required = l.required[name+"."+field.Name()]
+		a.Optional = !a.Required
+		s.Attributes = append(s.Attributes, a)
+	}
+	sortAttributes(s.Attributes)
+
+	l.structs[name] = s
+
+	return s
+}
+
+// identifier maps a required input field to the attribute holding its value.
+// If no attribute corresponds to the field, the id attribute is used.
+func (l *loader) identifier(field *types.Var, attributes map[string]*Attribute) *Identifier {
+	id := &Identifier{
+		Field:   field.Name(),
+		Param:   paramName(field.Name()),
+		Pointer: isPointer(field.Type()),
+	}
+
+	for _, candidate := range l.candidates(field.Name()) {
+		for _, a := range attributes {
+			if slices.ContainsFunc(a.sdkNames, func(v string) bool { return strings.EqualFold(v, candidate) }) {
+				id.Attribute = a
+				return id
+			}
+		}
+	}
+
+	a, ok := attributes[names.AttrID]
+	if !ok {
+		a = &Attribute{
+			Name:      names.AttrID,
+			Key:       l.key(names.AttrID),
+			FieldName: "ID",
+			Computed:  true,
+			kind:      kindString,
+			sdkNames:  []string{"Id"},
+		}
+		attributes[a.Name] = a
+	}
+	id.Attribute = a
+
+	return id
+}
+
+// candidates returns the field names which may correspond to an identifying field, in order of preference.
+// For example, a QueueIdentifier field may be matched by QueueIdentifier, Identifier, QueueId, Id, QueueArn, Arn, QueueName and Name fields.
+func (l *loader) candidates(name string) []string {
+	bases := []string{name}
+	if v := strings.TrimPrefix(name, l.prefix); v != name && v != "" {
+		bases = append(bases, v)
+	}
+
+	result := slices.Clone(bases)
+	for _, suffix := range []string{"Id", "Arn", "Name"} {
+		for _, base := range bases {
+			for _, from := range []string{"Identifier", "Id", "Arn", "Name"} {
+				if v, ok := strings.CutSuffix(base, from); ok {
+					result = appendUnique(result, v+suffix)
+					break
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (l *loader) notFoundException(name string) string {
+	for _, v := range []string{name + "NotFoundException", name + "NotFoundFault", "ResourceNotFoundException", "NotFoundException"} {
+		if l.types.Types.Scope().Lookup(v) != nil {
+			return v
+		}
+	}
+
+	return "ResourceNotFoundException"
+}
+
+// status returns the resource object's status field, preferring Status, then <Resource>Status, then State.
+func (l *loader) status(object *types.Struct) *Status {
+	var field *types.Var
+	for _, name := range []string{"Status", l.prefix + "Status", "State"} {
+		for v := range fields(object) {
+			if v.Name() == name {
+				field = v
+				break
+			}
+		}
+		if field != nil {
+			break
+		}
+	}
+	if field == nil {
+		return nil
+	}
+
+	t := field.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || !l.isEnum(named) {
+		return nil
+	}
+	s := &Status{
+		Field:   field.Name(),
+		Enum:    named.Obj().Name(),
+		Pointer: isPointer(field.Type()),
+	}
+
+	scope := l.types.Types.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), named) {
+			continue
+		}
+
+		value := strings.ToLower(strings.NewReplacer("_", "", "-", "", " ", "").Replace(constant.StringVal(c.Val())))
+		switch {
+		case slices.Contains(creatingStatuses, value):
+			s.Creating = append(s.Creating, name)
+		case slices.Contains(updatingStatuses, value):
+			s.Updating = append(s.Updating, name)
+		case slices.Contains(deletingStatuses, value):
+			s.Deleting = append(s.Deleting, name)
+		case slices.Contains(targetStatuses, value):
+			s.Target = append(s.Target, name)
+		}
+	}
+
+	return s
+}
+
+func (l *loader) sweeper(op string, identifiers []*Identifier) (*Sweeper, error) {
+	input, err := l.operationStruct(op, "Input")
+	if err != nil {
+		return nil, err
+	}
+	output, err := l.operationStruct(op, "Output")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Sweeper{
+		Paginator: l.service.Types.Scope().Lookup("New"+op+"Paginator") != nil,
+	}
+
+	for field := range fields(input) {
+		if l.required[op+"Input."+field.Name()] {
+			s.Required = append(s.Required, field.Name())
+		}
+	}
+
+	var item *types.Struct
+	for field := range fields(output) {
+		slice, ok := field.Type().(*types.Slice)
+		if !ok {
+			continue
+		}
+		if st, ok := slice.Elem().Underlying().(*types.Struct); ok && l.inTypes(slice.Elem()) {
+			s.ItemsField = field.Name()
+			item = st
+			break
+		}
+	}
+
+	for _, id := range identifiers {
+		sa := &SweepAttribute{
+			Key: id.Attribute.Key,
+		}
+
+		if item != nil {
+			candidates := slices.Concat(id.Attribute.sdkNames, l.candidates(id.Field))
+		outer:
+			for _, candidate := range candidates {
+				for field := range fields(item) {
+					if strings.EqualFold(field.Name(), candidate) {
+						sa.Field = field.Name()
+						sa.Pointer = isPointer(field.Type())
+						break outer
+					}
+				}
+			}
+		}
+
+		s.Attributes = append(s.Attributes, sa)
+	}
+
+	return s, nil
+}
+
+// tfName returns the Terraform name of a field, without any resource name prefix.
+func (l *loader) tfName(name string) string {
+	if v := strings.TrimPrefix(name, l.prefix); l.prefix != "" && v != name && v != "" && v[0] >= 'A' && v[0] <= 'Z' {
+		name = v
+	}
+
+	return names.ToSnakeCase(name)
+}
+
+// key returns the names.Attr constant for a Terraform name, if one exists.
+func (l *loader) key(name string) string {
+	if v, ok := l.attrConsts[name]; ok {
+		return "names." + v
+	}
+
+	return fmt.Sprintf("%q", name)
+}
+
+func (l *loader) inTypes(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == l.types.PkgPath
+}
+
+func (l *loader) isEnum(t *types.Named) bool {
+	return l.inTypes(t) && isString(t.Underlying())
+}
+
+func (l *loader) isStruct(t *types.Named) bool {
+	_, ok := t.Underlying().(*types.Struct)
+	return l.inTypes(t) && ok
+}
+
+func (l *loader) typeName(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+
+	return ""
+}
+
+// fields iterates over the exported fields of a struct.
+func fields(st *types.Struct) func(func(*types.Var) bool) {
+	return func(yield func(*types.Var) bool) {
+		if st == nil {
+			return
+		}
+		for i := range st.NumFields() {
+			if field := st.Field(i); field.Exported() {
+				if !yield(field) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func fieldType(st *types.Struct, name string) types.Type {
+	for field := range fields(st) {
+		if field.Name() == name {
+			return field.Type()
+		}
+	}
+
+	return nil
+}
+
+func skipped(field *types.Var) bool {
+	return slices.Contains(skippedFields, field.Name())
+}
+
+func basicKind(t *types.Basic) kind {
+	switch t.Kind() {
+	case types.String:
+		return kindString
+	case types.Bool:
+		return kindBool
+	case types.Int32:
+		return kindInt32
+	case types.Int64:
+		return kindInt64
+	case types.Float32:
+		return kindFloat32
+	case types.Float64:
+		return kindFloat64
+	default:
+		return kindUnsupported
+	}
+}
+
+func isString(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+func isPointer(t types.Type) bool {
+	_, ok := t.(*types.Pointer)
+	return ok
+}
+
+var initialisms = []string{"acl", "api", "arn", "cidr", "dns", "http", "https", "iam", "id", "ip", "json", "kms", "sql", "ssl", "tls", "ttl", "uri", "url", "vpc"}
+
+// FieldName returns the model field name of a Terraform name, e.g. KMSKeyARN for kms_key_arn.
+func FieldName(name string) string {
+	var sb strings.Builder
+	for word := range strings.SplitSeq(name, "_") {
+		if slices.Contains(initialisms, word) {
+			sb.WriteString(strings.ToUpper(word))
+			continue
+		}
+		if word != "" {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return sb.String()
+}
+
+// paramName returns the name of a finder parameter for an input field, e.g. queueID for QueueIdentifier.
+func paramName(field string) string {
+	for _, v := range []struct{ from, to string }{{"Identifier", "ID"}, {"Id", "ID"}, {"Arn", "ARN"}} {
+		if s, ok := strings.CutSuffix(field, v.from); ok {
+			field = s + v.to
+			break
+		}
+	}
+
+	return convert.ToLowercasePrefix(field)
+}
+
+func sortAttributes(attributes []*Attribute) {
+	slices.SortFunc(attributes, func(a, b *Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func appendUnique(s []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package model
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testServicePath = "github.com/hashicorp/terraform-provider-aws/skaff/model/testdata/widget"
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	r, err := load(".", testServicePath, "Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+		List:   "ListWidgets",
+	})
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	type flags struct {
+		Required, Optional, Computed, RequiresReplace bool
+	}
+	expected := map[string]flags{
+		"arn":           {Computed: true},
+		"configuration": {Optional: true, RequiresReplace: true},
+		"created_at":    {Computed: true},
+		"description":   {Optional: true},
+		"id":            {Computed: true},
+		"labels":        {Computed: true},
+		"name":          {Required: true, RequiresReplace: true},
+		"ports":         {Computed: true},
+		"status":        {Computed: true},
+	}
+
+	var got []string
+	for _, a := range r.Attributes {
+		got = append(got, a.Name)
+		if want, ok := expected[a.Name]; ok {
+			if got := (flags{a.Required, a.Optional, a.Computed, a.RequiresReplace}); got != want {
+				t.Errorf("attribute %s: got %+v, expected %+v", a.Name, got, want)
+			}
+		}
+	}
+	if want := []string{"arn", "configuration", "created_at", "description", "id", "labels", "name", "ports", "status"}; !slices.Equal(got, want) {
+		t.Errorf("attributes: got %v, expected %v", got, want)
+	}
+
+	if !r.Tags {
+		t.Error("expected tags")
+	}
+	if got, want := r.Object, "awstypes.Widget"; got != want {
+		t.Errorf("object: got %s, expected %s", got, want)
+	}
+	if got, want := r.FinderName(), "findWidgetByID"; got != want {
+		t.Errorf("finder: got %s, expected %s", got, want)
+	}
+	if got, want := r.Params(), "widgetID string"; got != want {
+		t.Errorf("params: got %s, expected %s", got, want)
+	}
+	if got, want := r.Args("state"), "state.ID.ValueString()"; got != want {
+		t.Errorf("args: got %s, expected %s", got, want)
+	}
+	if got, want := r.UpdateInput("plan"), "input.WidgetIdentifier = plan.ID.ValueStringPointer()\n"; got != want {
+		t.Errorf("update input: got %q, expected %q", got, want)
+	}
+	if got, want := r.NotFoundException, "ResourceNotFoundException"; got != want {
+		t.Errorf("not found exception: got %s, expected %s", got, want)
+	}
+
+	if r.Status == nil {
+		t.Fatal("expected status")
+	}
+	if got, want := r.Status.CreatePending(), "enum.Slice(awstypes.WidgetStatusCreating)"; got != want {
+		t.Errorf("create pending: got %s, expected %s", got, want)
+	}
+	if got, want := r.Status.TargetStatus(), "enum.Slice(awstypes.WidgetStatusActive)"; got != want {
+		t.Errorf("target: got %s, expected %s", got, want)
+	}
+	if got, want := r.Status.Value("out"), "string(out.Status)"; got != want {
+		t.Errorf("status value: got %s, expected %s", got, want)
+	}
+
+	if r.Sweeper == nil {
+		t.Fatal("expected sweeper")
+	}
+	if !r.Sweeper.Paginator || r.Sweeper.ItemsField != "Widgets" {
+		t.Errorf("sweeper: got %+v", r.Sweeper)
+	}
+	if got, want := r.Sweeper.SweepAttributes("v"), "sweepfw.NewAttribute(names.AttrID, aws.ToString(v.Id))"; got != want {
+		t.Errorf("sweep attributes: got %s, expected %s", got, want)
+	}
+
+	for _, want := range []string{
+		"Configuration fwtypes.ListNestedObjectValueOf[configurationModel] `tfsdk:\"configuration\"`",
+		"CreatedAt timetypes.RFC3339 `tfsdk:\"created_at\"`",
+		"ID types.String `tfsdk:\"id\"`",
+		"Labels fwtypes.ListOfString `tfsdk:\"labels\"`",
+		"Status fwtypes.StringEnum[awstypes.WidgetStatus] `tfsdk:\"status\"`",
+		"TagsAll tftags.Map `tfsdk:\"tags_all\"`",
+		"// TODO: Ports ([]int) is not supported by skaff.",
+	} {
+		if got := r.ModelFields(); !strings.Contains(got, want) {
+			t.Errorf("model fields: %q not found in\n%s", want, got)
+		}
+	}
+
+	for _, want := range []string{
+		"Mode fwtypes.StringEnum[awstypes.Mode] `tfsdk:\"mode\"`",
+		"Options fwtypes.MapOfString `tfsdk:\"options\"`",
+		"Size types.Int32 `tfsdk:\"size\"`",
+	} {
+		if got := r.NestedModels(); !strings.Contains(got, want) {
+			t.Errorf("nested models: %q not found in\n%s", want, got)
+		}
+	}
+
+	for _, want := range []string{
+		"listvalidator.SizeAtMost(1),",
+		"listplanmodifier.RequiresReplace(),",
+	} {
+		if got := r.SchemaBlocks(); !strings.Contains(got, want) {
+			t.Errorf("schema blocks: %q not found in\n%s", want, got)
+		}
+	}
+}
+
+func TestLoadUnknownOperation(t *testing.T) {
+	t.Parallel()
+
+	_, err := load(".", testServicePath, "Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "DescribeWidget",
+		Delete: "DeleteWidget",
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			Input:    "name",
+			Expected: "Name",
+		},
+		{
+			TestName: "initialisms",
+			Input:    "kms_key_arn",
+			Expected: "KMSKeyARN",
+		},
+		{
+			TestName: "id",
+			Input:    "cluster_id",
+			Expected: "ClusterID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := FieldName(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
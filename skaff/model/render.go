@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The methods in this file render Go source snippets for the resource template.
+// The snippets are not indented; the generated file is formatted after the template is executed.
+
+// SchemaAttributes renders the entries of the resource schema's Attributes map.
+func (r *Resource) SchemaAttributes() string {
+	var sb strings.Builder
+	for _, a := range r.Attributes {
+		if !a.block() {
+			writeAttribute(&sb, a, true)
+		}
+	}
+	if r.Tags {
+		fmt.Fprintln(&sb, "names.AttrTags: tftags.TagsAttribute(),")
+		fmt.Fprintln(&sb, "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),")
+	}
+
+	return sb.String()
+}
+
+// SchemaBlocks renders the entries of the resource schema's Blocks map, except for timeouts.
+func (r *Resource) SchemaBlocks() string {
+	var sb strings.Builder
+	for _, a := range r.Attributes {
+		if a.block() {
+			writeBlock(&sb, a)
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// ModelFields renders the fields of the resource model.
+func (r *Resource) ModelFields() string {
+	type field struct {
+		name, goType, tfName string
+	}
+	fields := []field{{"Timeouts", "timeouts.Value", names.AttrTimeouts}}
+	if r.Tags {
+		fields = append(fields, field{"Tags", "tftags.Map", names.AttrTags}, field{"TagsAll", "tftags.Map", names.AttrTagsAll})
+	}
+	var sb strings.Builder
+	for _, a := range r.Attributes {
+		if goType, ok := a.goFieldType(); ok {
+			fields = append(fields, field{a.FieldName, goType, a.Name})
+		} else {
+			fmt.Fprintf(&sb, "// TODO: %s (%s) is not supported by skaff.\n", a.FieldName, a.goType)
+		}
+	}
+	slices.SortFunc(fields, func(a, b field) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	for _, f := range fields {
+		fmt.Fprintf(&sb, "%s %s `tfsdk:\"%s\"`\n", f.name, f.goType, f.tfName)
+	}
+
+	return sb.String()
+}
+
+// NestedModels renders the nested object model types.
+func (r *Resource) NestedModels() string {
+	var sb strings.Builder
+	for _, s := range r.Structs {
+		fmt.Fprintf(&sb, "\ntype %s struct {\n", s.Name)
+		for _, a := range s.Attributes {
+			writeField(&sb, a)
+		}
+		fmt.Fprintln(&sb, "}")
+	}
+
+	return sb.String()
+}
+
+// FinderName returns the name of the finder, e.g. findQueueByTwoPartKey.
+func (r *Resource) FinderName() string {
+	suffix := "ByID"
+	switch n := len(r.Identifiers); n {
+	case 0, 1:
+	case 2, 3, 4:
+		suffix = "By" + []string{"Two", "Three", "Four"}[n-2] + "PartKey"
+	default:
+		suffix = "ByKey"
+	}
+
+	return "find" + r.Name + suffix
+}
+
+// Params renders the finder's parameter list, e.g. `clusterID, queueID string`.
+func (r *Resource) Params() string {
+	if len(r.Identifiers) == 0 {
+		return "id string"
+	}
+
+	return r.ParamNames() + " string"
+}
+
+// ParamNames renders the finder's parameter names, e.g. `clusterID, queueID`.
+func (r *Resource) ParamNames() string {
+	if len(r.Identifiers) == 0 {
+		return "id"
+	}
+
+	params := make([]string, len(r.Identifiers))
+	for i, id := range r.Identifiers {
+		params[i] = id.Param
+	}
+
+	return strings.Join(params, ", ")
+}
+
+// Args renders the finder's arguments from a model variable, e.g. `plan.ClusterIdentifier.ValueString(), plan.ID.ValueString()`.
+func (r *Resource) Args(receiver string) string {
+	if len(r.Identifiers) == 0 {
+		return receiver + ".ID.ValueString()"
+	}
+
+	args := make([]string, len(r.Identifiers))
+	for i, id := range r.Identifiers {
+		args[i] = receiver + "." + id.Attribute.FieldName + ".ValueString()"
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// Display renders the value identifying the resource in diagnostics, preferring its name.
+func (r *Resource) Display(receiver string) string {
+	for _, a := range r.Attributes {
+		if a.Name == names.AttrName {
+			return receiver + "." + a.FieldName + ".String()"
+		}
+	}
+	if len(r.Identifiers) > 0 {
+		return receiver + "." + r.Identifiers[len(r.Identifiers)-1].Attribute.FieldName + ".String()"
+	}
+
+	return receiver + ".ID.String()"
+}
+
+// FinderInput renders the fields of the read operation's input.
+func (r *Resource) FinderInput() string {
+	var sb strings.Builder
+	for _, id := range r.Identifiers {
+		if id.Pointer {
+			fmt.Fprintf(&sb, "%s: aws.String(%s),\n", id.Field, id.Param)
+		} else {
+			fmt.Fprintf(&sb, "%s: %s,\n", id.Field, id.Param)
+		}
+	}
+
+	return sb.String()
+}
+
+// DeleteInput renders the fields of the delete operation's input.
+func (r *Resource) DeleteInput(receiver string) string {
+	var sb strings.Builder
+	for _, id := range r.DeleteIdentifiers {
+		fmt.Fprintf(&sb, "%s: %s,\n", id.Field, id.value(receiver))
+	}
+
+	return sb.String()
+}
+
+// UpdateInput renders the assignments of the update operation's identifying input fields.
+func (r *Resource) UpdateInput(receiver string) string {
+	var sb strings.Builder
+	for _, id := range r.UpdateIdentifiers {
+		fmt.Fprintf(&sb, "input.%s = %s\n", id.Field, id.value(receiver))
+	}
+
+	return sb.String()
+}
+
+func (id *Identifier) value(receiver string) string {
+	if id.Pointer {
+		return receiver + "." + id.Attribute.FieldName + ".ValueStringPointer()"
+	}
+
+	return receiver + "." + id.Attribute.FieldName + ".ValueString()"
+}
+
+// Value renders the status of a resource object as a string.
+func (s *Status) Value(receiver string) string {
+	if s.Pointer {
+		return "string(*" + receiver + "." + s.Field + ")"
+	}
+
+	return "string(" + receiver + "." + s.Field + ")"
+}
+
+// CreatePending renders the statuses of a resource being created.
+func (s *Status) CreatePending() string {
+	return s.values(s.Creating)
+}
+
+// UpdatePending renders the statuses of a resource being updated.
+func (s *Status) UpdatePending() string {
+	return s.values(s.Updating)
+}
+
+// DeletePending renders the statuses of a resource being deleted.
+// If the status has no deleting values, the target statuses are used.
+func (s *Status) DeletePending() string {
+	if len(s.Deleting) == 0 {
+		return s.values(s.Target)
+	}
+
+	return s.values(s.Deleting)
+}
+
+// TargetStatus renders the statuses of a resource which has been created or updated.
+func (s *Status) TargetStatus() string {
+	return s.values(s.Target)
+}
+
+func (s *Status) values(consts []string) string {
+	if len(consts) == 0 {
+		return "[]string{}"
+	}
+
+	values := make([]string, len(consts))
+	for i, v := range consts {
+		values[i] = "awstypes." + v
+	}
+
+	return "enum.Slice(" + strings.Join(values, ", ") + ")"
+}
+
+// SweepAttributes renders the sweep resource attributes of a list item, separated by commas.
+func (s *Sweeper) SweepAttributes(receiver string) string {
+	attributes := make([]string, len(s.Attributes))
+	for i, a := range s.Attributes {
+		switch {
+		case a.Field == "":
+			attributes[i] = fmt.Sprintf("// TODO: Set from the list item.\nsweepfw.NewAttribute(%s, \"\")", a.Key)
+		case a.Pointer:
+			attributes[i] = fmt.Sprintf("sweepfw.NewAttribute(%s, aws.ToString(%s.%s))", a.Key, receiver, a.Field)
+		default:
+			attributes[i] = fmt.Sprintf("sweepfw.NewAttribute(%s, %s.%s)", a.Key, receiver, a.Field)
+		}
+	}
+
+	return strings.Join(attributes, ",\n")
+}
+
+func (a *Attribute) block() bool {
+	return a.kind == kindStruct && !a.Computed
+}
+
+func writeAttribute(sb *strings.Builder, a *Attribute, topLevel bool) {
+	if topLevel && a.Computed {
+		switch a.Name {
+		case names.AttrARN:
+			fmt.Fprintf(sb, "%s: framework.ARNAttributeComputedOnly(),\n", a.Key)
+			return
+		case names.AttrID:
+			fmt.Fprintf(sb, "%s: framework.IDAttribute(),\n", a.Key)
+			return
+		}
+	}
+
+	var schemaType, customType, planModifier string
+	switch a.kind {
+	case kindString:
+		schemaType, planModifier = "String", "string"
+	case kindEnum:
+		schemaType, planModifier = "String", "string"
+		customType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", a.elem)
+	case kindBool:
+		schemaType, planModifier = "Bool", "bool"
+	case kindInt32:
+		schemaType, planModifier = "Int32", "int32"
+	case kindInt64:
+		schemaType, planModifier = "Int64", "int64"
+	case kindFloat32:
+		schemaType, planModifier = "Float32", "float32"
+	case kindFloat64:
+		schemaType, planModifier = "Float64", "float64"
+	case kindTime:
+		schemaType, planModifier = "String", "string"
+		customType = "timetypes.RFC3339Type{}"
+	case kindStringList:
+		schemaType, planModifier = "List", "list"
+		customType = "fwtypes.ListOfStringType"
+	case kindEnumList:
+		schemaType, planModifier = "List", "list"
+		customType = fmt.Sprintf("fwtypes.ListOfStringEnumType[awstypes.%s]()", a.elem)
+	case kindStringMap:
+		schemaType, planModifier = "Map", "map"
+		customType = "fwtypes.MapOfStringType"
+	case kindStruct:
+		schemaType = "List"
+		customType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", a.nested.Name)
+	default:
+		fmt.Fprintf(sb, "// TODO: %s (%s) is not supported by skaff.\n", a.Key, a.goType)
+		return
+	}
+
+	fmt.Fprintf(sb, "%s: schema.%sAttribute{\n", a.Key, schemaType)
+	if customType != "" {
+		fmt.Fprintf(sb, "CustomType: %s,\n", customType)
+	}
+	writeFlags(sb, a)
+	if a.kind == kindStruct {
+		fmt.Fprintf(sb, "ElementType: fwtypes.NewObjectTypeOf[%s](ctx),\n", a.nested.Name)
+	}
+	if topLevel && a.RequiresReplace {
+		fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n", schemaType)
+		fmt.Fprintf(sb, "%splanmodifier.RequiresReplace(),\n", planModifier)
+		fmt.Fprintln(sb, "},")
+	}
+	fmt.Fprintln(sb, "},")
+}
+
+func writeBlock(sb *strings.Builder, a *Attribute) {
+	fmt.Fprintf(sb, "%s: schema.ListNestedBlock{\n", a.Key)
+	fmt.Fprintf(sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", a.nested.Name)
+	if a.RequiresReplace {
+		fmt.Fprintln(sb, "PlanModifiers: []planmodifier.List{")
+		fmt.Fprintln(sb, "listplanmodifier.RequiresReplace(),")
+		fmt.Fprintln(sb, "},")
+	}
+	if a.Required || !a.list {
+		fmt.Fprintln(sb, "Validators: []validator.List{")
+		if a.Required {
+			fmt.Fprintln(sb, "listvalidator.IsRequired(),")
+		}
+		if !a.list {
+			fmt.Fprintln(sb, "listvalidator.SizeAtMost(1),")
+		}
+		fmt.Fprintln(sb, "},")
+	}
+	fmt.Fprintln(sb, "NestedObject: schema.NestedBlockObject{")
+	var attributes, blocks []*Attribute
+	for _, v := range a.nested.Attributes {
+		if v.block() {
+			blocks = append(blocks, v)
+		} else {
+			attributes = append(attributes, v)
+		}
+	}
+	if len(attributes) > 0 {
+		fmt.Fprintln(sb, "Attributes: map[string]schema.Attribute{")
+		for _, v := range attributes {
+			writeAttribute(sb, v, false)
+		}
+		fmt.Fprintln(sb, "},")
+	}
+	if len(blocks) > 0 {
+		fmt.Fprintln(sb, "Blocks: map[string]schema.Block{")
+		for _, v := range blocks {
+			writeBlock(sb, v)
+		}
+		fmt.Fprintln(sb, "},")
+	}
+	fmt.Fprintln(sb, "},")
+	fmt.Fprintln(sb, "},")
+}
+
+func writeFlags(sb *strings.Builder, a *Attribute) {
+	switch {
+	case a.Computed:
+		fmt.Fprintln(sb, "Computed: true,")
+	case a.Required:
+		fmt.Fprintln(sb, "Required: true,")
+	default:
+		fmt.Fprintln(sb, "Optional: true,")
+	}
+}
+
+func writeField(sb *strings.Builder, a *Attribute) {
+	goType, ok := a.goFieldType()
+	if !ok {
+		fmt.Fprintf(sb, "// TODO: %s (%s) is not supported by skaff.\n", a.FieldName, a.goType)
+		return
+	}
+
+	fmt.Fprintf(sb, "%s %s `tfsdk:\"%s\"`\n", a.FieldName, goType, a.Name)
+}
+
+// goFieldType returns the model field type of an attribute.
+func (a *Attribute) goFieldType() (string, bool) {
+	switch a.kind {
+	case kindString:
+		return "types.String", true
+	case kindEnum:
+		return fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", a.elem), true
+	case kindBool:
+		return "types.Bool", true
+	case kindInt32:
+		return "types.Int32", true
+	case kindInt64:
+		return "types.Int64", true
+	case kindFloat32:
+		return "types.Float32", true
+	case kindFloat64:
+		return "types.Float64", true
+	case kindTime:
+		return "timetypes.RFC3339", true
+	case kindStringList:
+		return "fwtypes.ListOfString", true
+	case kindEnumList:
+		return fmt.Sprintf("fwtypes.ListOfStringEnum[awstypes.%s]", a.elem), true
+	case kindStringMap:
+		return "fwtypes.MapOfString", true
+	case kindStruct:
+		return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", a.nested.Name), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package widget mimics the shapes of an AWS SDK for Go v2 service package.
+package widget
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/model/testdata/widget/types"
+)
+
+type Client struct{}
+
+type CreateWidgetInput struct {
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	ClientToken *string
+
+	Configuration *types.Configuration
+
+	Description *string
+
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+	Widget *types.Widget
+
+	noSmithyDocumentSerde
+}
+
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput) (*CreateWidgetOutput, error) {
+	return nil, nil
+}
+
+type GetWidgetInput struct {
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+	// This member is required.
+	Widget *types.Widget
+
+	noSmithyDocumentSerde
+}
+
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput) (*GetWidgetOutput, error) {
+	return nil, nil
+}
+
+type UpdateWidgetInput struct {
+	// This member is required.
+	WidgetIdentifier *string
+
+	Description *string
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+	Widget *types.Widget
+
+	noSmithyDocumentSerde
+}
+
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput) (*UpdateWidgetOutput, error) {
+	return nil, nil
+}
+
+type DeleteWidgetInput struct {
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+	noSmithyDocumentSerde
+}
+
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput) (*DeleteWidgetOutput, error) {
+	return nil, nil
+}
+
+type ListWidgetsInput struct {
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsOutput struct {
+	// This member is required.
+	Widgets []types.WidgetSummary
+
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput) (*ListWidgetsOutput, error) {
+	return nil, nil
+}
+
+type ListWidgetsPaginator struct{}
+
+func NewListWidgetsPaginator(client *Client, params *ListWidgetsInput) *ListWidgetsPaginator {
+	return &ListWidgetsPaginator{}
+}
+
+type noSmithyDocumentSerde struct{}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+type Mode string
+
+const (
+	ModeFast Mode = "FAST"
+	ModeSlow Mode = "SLOW"
+)
+
+type WidgetStatus string
+
+const (
+	WidgetStatusActive       WidgetStatus = "ACTIVE"
+	WidgetStatusCreateFailed WidgetStatus = "CREATE_FAILED"
+	WidgetStatusCreating     WidgetStatus = "CREATING"
+	WidgetStatusDeleting     WidgetStatus = "DELETING"
+	WidgetStatusUpdating     WidgetStatus = "UPDATING"
+)
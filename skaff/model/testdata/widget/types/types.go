@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"time"
+)
+
+type Configuration struct {
+	// This member is required.
+	Mode Mode
+
+	Options map[string]string
+
+	Size *int32
+
+	noSmithyDocumentSerde
+}
+
+type Widget struct {
+	// This member is required.
+	Arn *string
+
+	Configuration *Configuration
+
+	CreatedAt *time.Time
+
+	Description *string
+
+	// This member is required.
+	Id *string
+
+	Labels []string
+
+	// This member is required.
+	Name *string
+
+	Ports []int
+
+	// This member is required.
+	Status WidgetStatus
+
+	noSmithyDocumentSerde
+}
+
+type WidgetSummary struct {
+	// This member is required.
+	Arn *string
+
+	// This member is required.
+	Id *string
+
+	noSmithyDocumentSerde
+}
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+
+func (e *ResourceNotFoundException) Error() string {
+	return "not found"
+}
+
+type noSmithyDocumentSerde struct{}
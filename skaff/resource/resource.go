@@ -5,6 +5,7 @@ package resource
 
 import (
 	"bytes"
+	"cmp"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/model"
+	"golang.org/x/tools/imports"
 )
 
 //go:embed resource.gtpl
@@ -46,9 +49,16 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	CreateOp             string
+	ReadOp               string
+	UpdateOp             string
+	DeleteOp             string
+	ListOp               string
+	// Model is derived from the operations' input and output shapes if operations are given.
+	Model *model.Resource
 }
 
-func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, pluginFramework, tags bool, ops model.Operations) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -77,6 +87,21 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
 	}
 
+	var m *model.Resource
+	if ops != (model.Operations{}) {
+		if !pluginFramework {
+			return fmt.Errorf("error checking: operations can only be given for Terraform Plugin Framework resources")
+		}
+
+		m, err = model.Load(wd, service.GoV2Package(), resName, ops)
+		if err != nil {
+			return fmt.Errorf("error loading %s operations: %w", service.GoV2Package(), err)
+		}
+
+		m.Tags = m.Tags || tags
+		tags = m.Tags
+	}
+
 	templateData := TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
@@ -92,6 +117,15 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		PluginFramework:      pluginFramework,
 		HumanResourceName:    convert.ToHumanResName(resName),
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+		CreateOp:             cmp.Or(ops.Create, "Create"+resName),
+		ReadOp:               cmp.Or(ops.Read, "Get"+resName),
+		UpdateOp:             cmp.Or(ops.Update, "Update"+resName),
+		DeleteOp:             cmp.Or(ops.Delete, "Delete"+resName),
+		ListOp:               cmp.Or(ops.List, "List"+resName+"s"),
+		Model:                m,
+	}
+	if m != nil {
+		templateData.UpdateOp = ops.Update
 	}
 
 	tmpl := resourceTmpl
@@ -138,13 +172,18 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	contents := buffer.Bytes()
+
+	// Code derived from a model is formatted, removing the imports it does not use.
+	if td.Model != nil && filepath.Ext(filename) == ".go" {
+		contents, err = imports.Process(filename, contents, nil)
+		if err != nil {
+			f.Close() // ignore error; Process error takes precedence
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
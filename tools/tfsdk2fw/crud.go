@@ -0,0 +1,690 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// sourcePackage is the parsed source of a service package.
+type sourcePackage struct {
+	fset  *token.FileSet
+	files []*ast.File
+}
+
+// loadSourcePackage parses the Go source files in the specified service package directory.
+func loadSourcePackage(dir string) (*sourcePackage, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &sourcePackage{
+		fset: token.NewFileSet(),
+	}
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(pkg.fset, filename, nil, parser.ParseComments|parser.SkipObjectResolution)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		pkg.files = append(pkg.files, file)
+	}
+
+	return pkg, nil
+}
+
+// resourceHandlers returns the names of the CRUD handlers of the Plugin SDK resource annotated with the specified type name,
+// keyed by schema.Resource field name, e.g. CreateWithoutTimeout.
+func (pkg *sourcePackage) resourceHandlers(typeName string) (map[string]string, error) {
+	annotation := fmt.Sprintf("@SDKResource(%q", typeName)
+
+	for _, file := range pkg.files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Doc == nil || !strings.Contains(fn.Doc.Text(), annotation) {
+				continue
+			}
+
+			handlers := make(map[string]string)
+
+			// The first schema.Resource literal is the resource itself; any others are nested blocks.
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				v, ok := n.(*ast.CompositeLit)
+				if !ok {
+					return true
+				}
+				if typ, ok := v.Type.(*ast.SelectorExpr); !ok || typ.Sel.Name != "Resource" {
+					return true
+				}
+
+				for _, v := range v.Elts {
+					if v, ok := v.(*ast.KeyValueExpr); ok {
+						key, ok1 := v.Key.(*ast.Ident)
+						value, ok2 := v.Value.(*ast.Ident)
+						if ok1 && ok2 {
+							handlers[key.Name] = value.Name
+						}
+					}
+				}
+
+				return false
+			})
+
+			return handlers, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s not found", annotation)
+}
+
+// funcDecl returns the top-level function declaration with the specified name and the imports of its file.
+func (pkg *sourcePackage) funcDecl(name string) (*ast.FuncDecl, []goImport) {
+	for _, file := range pkg.files {
+		for _, v := range file.Decls {
+			if v, ok := v.(*ast.FuncDecl); ok && v.Recv == nil && v.Name.Name == name {
+				renameSDKTypes(file, v)
+				return v, fileImports(file)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// fileImports returns a file's imports, excluding the Plugin SDK packages that migrated code no longer uses.
+// An AWS SDK for Go v2 types package imported without an alias is imported as awstypes.
+func fileImports(file *ast.File) []goImport {
+	var imports []goImport
+
+	for _, v := range file.Imports {
+		path, err := strconv.Unquote(v.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		switch path {
+		case "github.com/hashicorp/terraform-plugin-sdk/v2/diag", "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema":
+			continue
+		}
+
+		imp := goImport{Path: path}
+		if v.Name != nil {
+			imp.Alias = v.Name.Name
+		} else if isSDKTypesPackage(path) {
+			imp.Alias = "awstypes"
+		}
+		imports = append(imports, imp)
+	}
+
+	return imports
+}
+
+// renameSDKTypes renames references to an AWS SDK for Go v2 types package imported without an alias to awstypes,
+// as types refers to the Plugin Framework types package in migrated code.
+func renameSDKTypes(file *ast.File, fn *ast.FuncDecl) {
+	for _, v := range file.Imports {
+		if path, err := strconv.Unquote(v.Path.Value); err != nil || v.Name != nil || !isSDKTypesPackage(path) {
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if v, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := v.X.(*ast.Ident); ok && x.Name == "types" {
+					x.Name = "awstypes"
+				}
+			}
+			return true
+		})
+	}
+}
+
+func isSDKTypesPackage(path string) bool {
+	return strings.HasPrefix(path, "github.com/aws/aws-sdk-go-v2/service/") && strings.HasSuffix(path, "/types")
+}
+
+// crudTranslator rewrites the body of a Plugin SDK CRUD handler into the body of the equivalent Plugin Framework method.
+// Only mechanical patterns are translated; any statement still referring to schema.ResourceData or
+// the provider meta value is emitted as a TODO comment.
+type crudTranslator struct {
+	data   string                      // Name of the variable holding the planned (or current) state model.
+	old    string                      // Name of the variable holding the prior state model. Update only.
+	fields map[string]*schema.Schema   // Top-level attributes keyed by name.
+	values map[string]schema.ValueType // Local variables bound to model fields via GetOk.
+
+	d, meta string // Names of the handler's schema.ResourceData and meta parameters.
+	fset    *token.FileSet
+}
+
+func newCRUDTranslator(fset *token.FileSet, fields map[string]*schema.Schema, data, old string) *crudTranslator {
+	return &crudTranslator{
+		data:   data,
+		old:    old,
+		fields: fields,
+		values: make(map[string]schema.ValueType),
+		fset:   fset,
+	}
+}
+
+// translate returns the translated function body.
+func (t *crudTranslator) translate(fn *ast.FuncDecl) (string, error) {
+	params := fn.Type.Params.List
+	if len(params) != 3 || len(params[1].Names) != 1 || len(params[2].Names) != 1 {
+		return "", fmt.Errorf("%s: unexpected signature", fn.Name.Name)
+	}
+	t.d, t.meta = params[1].Names[0].Name, params[2].Names[0].Name
+
+	var buf bytes.Buffer
+	var end token.Pos
+	for i, stmt := range fn.Body.List {
+		stmts := t.translateStmt(stmt)
+
+		// The framework method sets state after the translated body.
+		if i == len(fn.Body.List)-1 && len(stmts) == 1 {
+			if v, ok := stmts[0].(*ast.ReturnStmt); ok && len(v.Results) == 0 {
+				break
+			}
+		}
+
+		// Preserve blank lines between statements.
+		if end.IsValid() && t.fset.Position(stmt.Pos()).Line-t.fset.Position(end).Line > 1 {
+			buf.WriteString("\n")
+		}
+		end = stmt.End()
+
+		for _, stmt := range stmts {
+			if err := printer.Fprint(&buf, t.fset, stmt); err != nil {
+				return "", err
+			}
+			buf.WriteString("\n")
+		}
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func (t *crudTranslator) translateStmts(stmts []ast.Stmt) []ast.Stmt {
+	var result []ast.Stmt
+
+	for _, stmt := range stmts {
+		result = append(result, t.translateStmt(stmt)...)
+	}
+
+	return result
+}
+
+func (t *crudTranslator) translateStmt(stmt ast.Stmt) []ast.Stmt {
+	switch stmt := stmt.(type) {
+	case *ast.DeclStmt:
+		// var diags diag.Diagnostics
+		if v, ok := stmt.Decl.(*ast.GenDecl); ok && v.Tok == token.VAR && len(v.Specs) == 1 {
+			if v, ok := v.Specs[0].(*ast.ValueSpec); ok && t.format(v.Type) == "diag.Diagnostics" {
+				return nil
+			}
+		}
+
+	case *ast.ReturnStmt:
+		if len(stmt.Results) == 1 {
+			return t.translateReturn(stmt)
+		}
+
+	case *ast.ExprStmt:
+		if call, ok := stmt.X.(*ast.CallExpr); ok {
+			switch t.resourceDataMethod(call) {
+			case "SetId":
+				if v, ok := call.Args[0].(*ast.BasicLit); ok && v.Value == `""` {
+					return []ast.Stmt{exprStmt("response.State.RemoveResource(ctx)")}
+				}
+				return []ast.Stmt{t.assign(t.data+".ID", "types.StringValue(%s)", t.rewrite(call.Args[0]))}
+			case "Set":
+				return t.translateSet(stmt, call)
+			}
+		}
+
+	case *ast.BlockStmt:
+		stmt.List = t.translateStmts(stmt.List)
+		return []ast.Stmt{stmt}
+
+	case *ast.IfStmt:
+		// if err := d.Set("name", v); err != nil { ... }
+		if v, ok := stmt.Init.(*ast.AssignStmt); ok && len(v.Rhs) == 1 {
+			if call, ok := v.Rhs[0].(*ast.CallExpr); ok && t.resourceDataMethod(call) == "Set" {
+				return t.translateSet(stmt, call)
+			}
+		}
+
+		// if v, ok := d.GetOk("name"); ok { ... }
+		if v, ok := stmt.Init.(*ast.AssignStmt); ok && len(v.Lhs) == 2 && len(v.Rhs) == 1 {
+			if call, ok := v.Rhs[0].(*ast.CallExpr); ok && t.resourceDataMethod(call) == "GetOk" {
+				if field, property, ok := t.field(call.Args[0]); ok {
+					value, found := v.Lhs[0].(*ast.Ident), v.Lhs[1].(*ast.Ident)
+					t.values[value.Name] = property.Type
+					v.Lhs, v.Rhs = v.Lhs[:1], []ast.Expr{parseExpr(t.data + "." + field)}
+					stmt.Cond = replaceIdent(stmt.Cond, found.Name, parseExpr(fmt.Sprintf("!%s.IsNull()", value.Name)))
+				}
+			}
+		}
+
+		stmt.Init = t.rewriteStmt(stmt.Init)
+		stmt.Cond = t.rewrite(stmt.Cond)
+		if t.refersToSDK(stmt.Init) || t.refersToSDK(stmt.Cond) {
+			return t.todo(stmt)
+		}
+		stmt.Body.List = t.translateStmts(stmt.Body.List)
+		if stmt.Else != nil {
+			if v := t.translateStmt(stmt.Else); len(v) == 1 {
+				stmt.Else = v[0]
+			} else {
+				stmt.Else = &ast.BlockStmt{List: v}
+			}
+		}
+		return []ast.Stmt{stmt}
+
+	case *ast.ForStmt:
+		stmt.Init, stmt.Cond, stmt.Post = t.rewriteStmt(stmt.Init), t.rewrite(stmt.Cond), t.rewriteStmt(stmt.Post)
+		if t.refersToSDK(stmt.Init) || t.refersToSDK(stmt.Cond) || t.refersToSDK(stmt.Post) {
+			return t.todo(stmt)
+		}
+		stmt.Body.List = t.translateStmts(stmt.Body.List)
+		return []ast.Stmt{stmt}
+
+	case *ast.RangeStmt:
+		stmt.X = t.rewrite(stmt.X)
+		if t.refersToSDK(stmt.X) {
+			return t.todo(stmt)
+		}
+		stmt.Body.List = t.translateStmts(stmt.Body.List)
+		return []ast.Stmt{stmt}
+
+	case *ast.SwitchStmt:
+		stmt.Init, stmt.Tag = t.rewriteStmt(stmt.Init), t.rewrite(stmt.Tag)
+		if t.refersToSDK(stmt.Init) || t.refersToSDK(stmt.Tag) {
+			return t.todo(stmt)
+		}
+		for _, v := range stmt.Body.List {
+			v := v.(*ast.CaseClause)
+			v.Body = t.translateStmts(v.Body)
+		}
+		return []ast.Stmt{stmt}
+	}
+
+	stmt = t.rewriteStmt(stmt)
+	if t.refersToSDK(stmt) {
+		return t.todo(stmt)
+	}
+
+	return []ast.Stmt{stmt}
+}
+
+// translateReturn translates a return from a Plugin SDK CRUD handler.
+// Errors are added to the response's diagnostics.
+func (t *crudTranslator) translateReturn(stmt *ast.ReturnStmt) []ast.Stmt {
+	ret := &ast.ReturnStmt{Return: stmt.Return}
+
+	switch v := stmt.Results[0].(type) {
+	case *ast.Ident:
+		// return diags
+		return []ast.Stmt{ret}
+
+	case *ast.CallExpr:
+		var args []string
+		for _, v := range v.Args {
+			args = append(args, t.format(t.rewrite(v)))
+		}
+
+		switch t.format(v.Fun) {
+		case "sdkdiag.AppendErrorf":
+			if len(args) > 1 {
+				return []ast.Stmt{addError(fmt.Sprintf("fmt.Errorf(%s)", strings.Join(args[1:], ", "))), ret}
+			}
+		case "sdkdiag.AppendFromErr":
+			if len(args) == 2 {
+				return []ast.Stmt{addError(args[1]), ret}
+			}
+		case "smerr.Append":
+			if len(args) > 2 {
+				return []ast.Stmt{addError(strings.Join(args[2:], ", ")), ret}
+			}
+		case "diag.FromErr":
+			if len(args) == 1 {
+				return []ast.Stmt{addError(args[0]), ret}
+			}
+		case "append":
+			// return append(diags, resourceExampleRead(ctx, d, meta)...)
+			if len(v.Args) == 2 && v.Ellipsis.IsValid() {
+				if call, ok := v.Args[1].(*ast.CallExpr); ok {
+					return append(todo(fmt.Sprintf("Set computed attributes in %s (previously done by %s).", t.data, t.format(call.Fun))), ret)
+				}
+			}
+		}
+	}
+
+	return t.todo(stmt)
+}
+
+// translateSet translates d.Set("name", v) into an assignment to the corresponding model field.
+func (t *crudTranslator) translateSet(stmt ast.Stmt, call *ast.CallExpr) []ast.Stmt {
+	field, property, ok := t.field(call.Args[0])
+	if !ok {
+		return t.todo(stmt)
+	}
+
+	lhs := t.data + "." + field
+	value := t.rewrite(call.Args[1])
+
+	// Values read from AWS API pointer fields via the aws.ToXxx helpers have direct framework equivalents.
+	if v, ok := value.(*ast.CallExpr); ok && len(v.Args) == 1 {
+		from, arg := t.format(v.Fun), v.Args[0]
+
+		switch {
+		case from == "aws.ToString" && property.Type == schema.TypeString:
+			return []ast.Stmt{t.assign(lhs, "fwflex.StringToFramework(ctx, %s)", arg)}
+		case from == "aws.ToBool" && property.Type == schema.TypeBool:
+			return []ast.Stmt{t.assign(lhs, "fwflex.BoolToFramework(ctx, %s)", arg)}
+		case from == "aws.ToInt64" && property.Type == schema.TypeInt:
+			return []ast.Stmt{t.assign(lhs, "fwflex.Int64ToFramework(ctx, %s)", arg)}
+		case from == "aws.ToInt32" && property.Type == schema.TypeInt:
+			return []ast.Stmt{t.assign(lhs, "fwflex.Int32ToFrameworkInt64(ctx, %s)", arg)}
+		}
+	}
+
+	if t.refersToSDK(value) {
+		return t.todo(stmt)
+	}
+
+	return append(todo("Convert to a framework value."), t.assign(lhs, "%s", value))
+}
+
+// rewrite rewrites any mechanically translatable expressions within the specified node.
+func (t *crudTranslator) rewrite(expr ast.Expr) ast.Expr {
+	if expr == nil {
+		return nil
+	}
+
+	return astutil.Apply(expr, t.pre, nil).(ast.Expr)
+}
+
+func (t *crudTranslator) rewriteStmt(stmt ast.Stmt) ast.Stmt {
+	if stmt == nil {
+		return nil
+	}
+
+	return astutil.Apply(stmt, t.pre, nil).(ast.Stmt)
+}
+
+func (t *crudTranslator) pre(c *astutil.Cursor) bool {
+	switch v := c.Node().(type) {
+	case *ast.BinaryExpr:
+		// !d.IsNewResource() && tfresource.NotFound(err)
+		if x, ok := v.X.(*ast.UnaryExpr); ok && v.Op == token.LAND && x.Op == token.NOT {
+			if call, ok := x.X.(*ast.CallExpr); ok && t.resourceDataMethod(call) == "IsNewResource" {
+				c.Replace(v.Y)
+			}
+		}
+
+	case *ast.TypeAssertExpr:
+		switch x := v.X.(type) {
+		// meta.(*conns.AWSClient)
+		case *ast.Ident:
+			if x.Name == t.meta {
+				c.Replace(parseExpr("r.Meta()"))
+				return false
+			}
+			if typ, ok := t.values[x.Name]; ok {
+				if expr, ok := valueOf(x.Name, typ, t.format(v.Type)); ok {
+					c.Replace(expr)
+				}
+				return false
+			}
+
+		// d.Get("name").(string)
+		case *ast.CallExpr:
+			if t.resourceDataMethod(x) == "Get" {
+				if field, property, ok := t.field(x.Args[0]); ok {
+					if expr, ok := valueOf(t.data+"."+field, property.Type, t.format(v.Type)); ok {
+						c.Replace(expr)
+						return false
+					}
+				}
+			}
+		}
+
+	case *ast.CallExpr:
+		switch t.resourceDataMethod(v) {
+		case "Id":
+			c.Replace(parseExpr(t.data + ".ID.ValueString()"))
+			return false
+
+		case "Get":
+			if field, _, ok := t.field(v.Args[0]); ok {
+				c.Replace(parseExpr(t.data + "." + field))
+				return false
+			}
+
+		case "HasChange", "HasChanges":
+			if t.old == "" {
+				break
+			}
+			var exprs []string
+			for _, v := range v.Args {
+				field, _, ok := t.field(v)
+				if !ok {
+					return true
+				}
+				exprs = append(exprs, fmt.Sprintf("!%[1]s.%[3]s.Equal(%[2]s.%[3]s)", t.data, t.old, field))
+			}
+			c.Replace(parseExpr(strings.Join(exprs, " || ")))
+			return false
+
+		case "Timeout":
+			if v, ok := v.Args[0].(*ast.SelectorExpr); ok {
+				if op, ok := strings.CutPrefix(v.Sel.Name, "Timeout"); ok {
+					c.Replace(parseExpr(fmt.Sprintf("r.%sTimeout(ctx, %s.Timeouts)", op, t.data)))
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// field returns the model field name and schema for a top-level attribute name literal.
+func (t *crudTranslator) field(expr ast.Expr) (string, *schema.Schema, bool) {
+	var name string
+
+	switch v := expr.(type) {
+	case *ast.BasicLit:
+		s, err := strconv.Unquote(v.Value)
+		if err != nil {
+			return "", nil, false
+		}
+		name = s
+	case *ast.SelectorExpr:
+		// names.AttrName
+		if x, ok := v.X.(*ast.Ident); !ok || x.Name != "names" {
+			return "", nil, false
+		}
+		for k := range t.fields {
+			if "Attr"+naming.ToCamelCase(k) == v.Sel.Name {
+				name = k
+				break
+			}
+		}
+	default:
+		return "", nil, false
+	}
+
+	property, ok := t.fields[name]
+	if !ok {
+		return "", nil, false
+	}
+
+	if name == "id" {
+		return "ID", property, true
+	}
+
+	return naming.ToCamelCase(name), property, true
+}
+
+// resourceDataMethod returns the name of the schema.ResourceData method called, if any.
+func (t *crudTranslator) resourceDataMethod(call *ast.CallExpr) string {
+	if v, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == t.d {
+			return v.Sel.Name
+		}
+	}
+
+	return ""
+}
+
+// refersToSDK returns whether the specified node still refers to the schema.ResourceData or meta parameters.
+func (t *crudTranslator) refersToSDK(node ast.Node) bool {
+	if node == nil || reflect.ValueOf(node).IsNil() {
+		return false
+	}
+
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.SelectorExpr:
+			ast.Inspect(v.X, func(n ast.Node) bool {
+				if v, ok := n.(*ast.Ident); ok && (v.Name == t.d || v.Name == t.meta) {
+					found = true
+				}
+				return !found
+			})
+			return false
+		case *ast.Ident:
+			if v.Name == t.d || v.Name == t.meta {
+				found = true
+			}
+		}
+		return !found
+	})
+
+	return found
+}
+
+// todo comments out a statement that could not be translated.
+func (t *crudTranslator) todo(stmt ast.Stmt) []ast.Stmt {
+	result := todo("Migrate the following:")
+	for line := range strings.SplitSeq(t.format(stmt), "\n") {
+		result = append(result, comment("// "+line))
+	}
+
+	return result
+}
+
+func (t *crudTranslator) assign(lhs, format string, arg any) ast.Stmt {
+	if v, ok := arg.(ast.Node); ok {
+		arg = t.format(v)
+	}
+
+	return &ast.AssignStmt{
+		Lhs: []ast.Expr{parseExpr(lhs)},
+		Tok: token.ASSIGN,
+		Rhs: []ast.Expr{parseExpr(fmt.Sprintf(format, arg))},
+	}
+}
+
+func (t *crudTranslator) format(node ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, t.fset, node); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// valueOf returns the expression that extracts the Go value of the specified type from a framework value.
+func valueOf(x string, typ schema.ValueType, goType string) (ast.Expr, bool) {
+	switch {
+	case typ == schema.TypeString && goType == "string":
+		return parseExpr(x + ".ValueString()"), true
+	case typ == schema.TypeBool && goType == "bool":
+		return parseExpr(x + ".ValueBool()"), true
+	case typ == schema.TypeInt && goType == "int":
+		return parseExpr(fmt.Sprintf("int(%s.ValueInt64())", x)), true
+	case typ == schema.TypeFloat && goType == "float64":
+		return parseExpr(x + ".ValueFloat64()"), true
+	}
+
+	return nil, false
+}
+
+// replaceIdent replaces all references to the named identifier.
+func replaceIdent(expr ast.Expr, name string, with ast.Expr) ast.Expr {
+	if expr == nil {
+		return nil
+	}
+
+	return astutil.Apply(expr, func(c *astutil.Cursor) bool {
+		if v, ok := c.Node().(*ast.Ident); ok && v.Name == name {
+			c.Replace(with)
+		}
+		return true
+	}, nil).(ast.Expr)
+}
+
+func addError(err string) ast.Stmt {
+	return exprStmt(fmt.Sprintf("smerr.AddError(ctx, &response.Diagnostics, %s)", err))
+}
+
+func exprStmt(s string) ast.Stmt {
+	return &ast.ExprStmt{X: parseExpr(s)}
+}
+
+func todo(s string) []ast.Stmt {
+	return []ast.Stmt{comment("// TODO " + s)}
+}
+
+// comment returns a statement that prints as the specified line comment.
+func comment(s string) ast.Stmt {
+	return &ast.ExprStmt{X: &ast.BasicLit{Kind: token.STRING, Value: s}}
+}
+
+// parseExpr parses a generated expression.
+// Positions are cleared as they are not relative to the file being translated.
+func parseExpr(s string) ast.Expr {
+	expr, err := parser.ParseExpr(s)
+	if err != nil {
+		panic(fmt.Sprintf("parsing %q: %s", s, err))
+	}
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		v := reflect.ValueOf(n).Elem()
+		for i := range v.NumField() {
+			if f := v.Field(i); f.Type() == reflect.TypeFor[token.Pos]() {
+				f.SetInt(int64(token.NoPos))
+			}
+		}
+		return true
+	})
+
+	return expr
+}
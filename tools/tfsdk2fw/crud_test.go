@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCRUDTranslator(t *testing.T) {
+	t.Parallel()
+
+	fields := map[string]*schema.Schema{
+		"arn":         {Type: schema.TypeString, Computed: true},
+		"description": {Type: schema.TypeString, Optional: true},
+		"enabled":     {Type: schema.TypeBool, Optional: true},
+		"id":          {Type: schema.TypeString, Computed: true},
+		"name":        {Type: schema.TypeString, Required: true},
+	}
+
+	testCases := []struct {
+		TestName string
+		Source   string
+		Data     string
+		Old      string
+		Expected []string
+	}{
+		{
+			TestName: "create",
+			Source: `
+func resourceExampleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := example.CreateExampleInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateExample(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Example (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	if _, err := waitExampleCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Example (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceExampleRead(ctx, d, meta)...)
+}`,
+			Data: "data",
+			Expected: []string{
+				"conn := r.Meta().ExampleClient(ctx)",
+				"name := data.Name.ValueString()",
+				"if v := data.Description; !v.IsNull() {",
+				"input.Description = aws.String(v.ValueString())",
+				`smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("creating Example (%s): %s", name, err))`,
+				"data.ID = types.StringValue(aws.ToString(output.Id))",
+				"waitExampleCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))",
+				"// TODO Set computed attributes in data (previously done by resourceExampleRead).",
+			},
+		},
+		{
+			TestName: "read",
+			Source: `
+func resourceExampleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	output, err := findExampleByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Example (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrName, aws.ToString(output.Name))
+	d.Set("enabled", aws.ToBool(output.Enabled))
+	if err := d.Set("settings", flattenSettings(output.Settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+	d.Set("description", d.Get("name"))
+
+	return diags
+}`,
+			Data: "data",
+			Expected: []string{
+				"if tfresource.NotFound(err) {",
+				"response.State.RemoveResource(ctx)",
+				"// TODO Convert to a framework value.\n\tdata.ARN = output.Arn",
+				"data.Name = fwflex.StringToFramework(ctx, output.Name)",
+				"data.Enabled = fwflex.BoolToFramework(ctx, output.Enabled)",
+				"// TODO Migrate the following:\n\t// if err := d.Set(\"settings\", flattenSettings(output.Settings)); err != nil {",
+				"data.Description = data.Name",
+			},
+		},
+		{
+			TestName: "update",
+			Source: `
+func resourceExampleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, "enabled") {
+		input := example.UpdateExampleInput{
+			Enabled: aws.Bool(d.Get("enabled").(bool)),
+			Id:      aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateExample(ctx, &input)
+
+		if err != nil {
+			return smerr.Append(ctx, diags, err, smerr.ID, d.Id())
+		}
+	}
+
+	return append(diags, resourceExampleRead(ctx, d, meta)...)
+}`,
+			Data: "new",
+			Old:  "old",
+			Expected: []string{
+				"if !new.Description.Equal(old.Description) || !new.Enabled.Equal(old.Enabled) {",
+				"Enabled: aws.Bool(new.Enabled.ValueBool()),",
+				"smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, new.ID.ValueString())",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "", "package example\n"+testCase.Source, 0)
+			if err != nil {
+				t.Fatalf("parsing: %s", err)
+			}
+
+			body, err := newCRUDTranslator(fset, fields, testCase.Data, testCase.Old).translate(file.Decls[0].(*ast.FuncDecl))
+			if err != nil {
+				t.Fatalf("translating: %s", err)
+			}
+
+			src, err := format.Source([]byte("package example\nfunc f() {\n" + body + "\n}\n"))
+			if err != nil {
+				t.Fatalf("formatting: %s\n%s", err, body)
+			}
+
+			for _, want := range testCase.Expected {
+				if !strings.Contains(string(src), want) {
+					t.Errorf("%q not found in\n%s", want, src)
+				}
+			}
+
+			if strings.Contains(string(src), "diag.Diagnostics") {
+				t.Errorf("diagnostics declaration not removed:\n%s", src)
+			}
+		})
+	}
+}
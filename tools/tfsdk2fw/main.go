@@ -17,17 +17,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 )
 
 var (
-	dataSourceType = flag.String("data-source", "", "Data Source type")
-	resourceType   = flag.String("resource", "", "Resource type")
+	dataSourceType  = flag.String("data-source", "", "Data Source type")
+	migrationTest   = flag.String("migration-test", "", "Generated migration acceptance test file (resources only)")
+	providerVersion = flag.String("provider-version", "", "Last provider release implementing the resource with the Plugin SDK")
+	resourceType    = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-migration-test <generated-test-file> -provider-version <version>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -36,7 +39,7 @@ func main() {
 
 	args := flag.Args()
 
-	if len(args) < 3 || (*dataSourceType == "" && *resourceType == "") {
+	if len(args) < 3 || (*dataSourceType == "" && *resourceType == "") || (*migrationTest != "" && (*resourceType == "" || *providerVersion == "")) {
 		flag.Usage()
 		os.Exit(2)
 	}
@@ -60,7 +63,7 @@ func main() {
 	p, err := sdkv2.NewProvider(context.Background())
 
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err)
 	}
 
 	if v := *dataSourceType; v != "" {
@@ -89,6 +92,12 @@ func main() {
 	if err := migrator.migrate(outputFilename); err != nil {
 		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
 	}
+
+	if v := *migrationTest; v != "" {
+		if err := migrator.migrateTest(v, *providerVersion); err != nil {
+			g.Fatalf("error generating Terraform %s migration test: %s", *resourceType, err)
+		}
+	}
 }
 
 type migrator struct {
@@ -128,6 +137,34 @@ func (m *migrator) migrate(outputFilename string) error {
 	return d.Write()
 }
 
+// migrateTest generates an acceptance test into the specified output file that creates the resource with
+// the specified (Plugin SDK) provider release and then expects an empty plan from the migrated resource.
+func (m *migrator) migrateTest(outputFilename, providerVersion string) error {
+	m.infof("generating migration test into %[1]q", outputFilename)
+
+	service, err := data.LookupService(m.PackageName)
+
+	if err != nil {
+		return fmt.Errorf("looking up service %s: %w", m.PackageName, err)
+	}
+
+	templateData := &testTemplateData{
+		Name:            m.Name,
+		PackageName:     m.PackageName,
+		ProviderVersion: providerVersion,
+		ServiceName:     service.ProviderNameUpper(),
+		TFTypeName:      m.TFTypeName,
+	}
+
+	d := m.Generator.NewGoFileDestination(outputFilename)
+
+	if err := d.BufferTemplate("test", migrationTestImpl, templateData); err != nil {
+		return err
+	}
+
+	return d.Write()
+}
+
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
@@ -144,6 +181,12 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	schemaVersion := int64(m.Resource.SchemaVersion)
+	if !m.IsDataSource {
+		// The Plugin SDK schema becomes the prior schema of the first framework schema version.
+		schemaVersion++
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
@@ -157,11 +200,21 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
+		PriorSchema:                  withSchemaVersion(sbSchema.String(), int64(m.Resource.SchemaVersion)),
+		PriorSchemaVersion:           int64(m.Resource.SchemaVersion),
+		Schema:                       withSchemaVersion(sbSchema.String(), schemaVersion),
+		SchemaVersion:                schemaVersion,
 		Struct:                       sbStruct.String(),
+		StructFields:                 emitter.StructFields,
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		if err := m.translateCRUD(templateData); err != nil {
+			m.Generator.Warnf("translating CRUD handlers: %s", err)
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -181,6 +234,83 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// translateCRUD translates the bodies of the Plugin SDK resource's CRUD handlers.
+// The handlers are located in the service package's source.
+func (m *migrator) translateCRUD(templateData *templateData) error {
+	pkg, err := loadSourcePackage(path.Join("internal", "service", m.PackageName))
+
+	if err != nil {
+		return err
+	}
+
+	names, err := pkg.resourceHandlers(m.TFTypeName)
+
+	if err != nil {
+		return err
+	}
+
+	handlers := []struct {
+		fields    []string
+		data, old string
+		body      *string
+	}{
+		{[]string{"CreateWithoutTimeout", "CreateContext", "Create"}, "data", "", &templateData.CreateBody},
+		{[]string{"ReadWithoutTimeout", "ReadContext", "Read"}, "data", "", &templateData.ReadBody},
+		{[]string{"UpdateWithoutTimeout", "UpdateContext", "Update"}, "new", "old", &templateData.UpdateBody},
+		{[]string{"DeleteWithoutTimeout", "DeleteContext", "Delete"}, "data", "", &templateData.DeleteBody},
+	}
+
+	// Only top-level attributes have model fields.
+	fields := make(map[string]*schema.Schema)
+	for name, property := range m.Resource.Schema {
+		if isAttribute(property) {
+			fields[name] = property
+		}
+	}
+
+	for _, v := range handlers {
+		var name string
+		for _, field := range v.fields {
+			if name = names[field]; name != "" {
+				break
+			}
+		}
+
+		if name == "" {
+			continue
+		}
+
+		fn, imports := pkg.funcDecl(name)
+
+		if fn == nil {
+			m.Generator.Warnf("function %s not found", name)
+			continue
+		}
+
+		m.infof("translating %s", name)
+
+		body, err := newCRUDTranslator(pkg.fset, fields, v.data, v.old).translate(fn)
+
+		if err != nil {
+			return err
+		}
+
+		*v.body = body
+
+		for _, v := range imports {
+			// The template imports these itself.
+			if v.Path == "context" || v.Path == "time" {
+				continue
+			}
+			if !slices.Contains(templateData.GoImports, v) {
+				templateData.GoImports = append(templateData.GoImports, v)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
@@ -201,6 +331,7 @@ type emitter struct {
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
 	SchemaWriter                  io.Writer
+	StructFields                  []string // Field names of the top-level model struct.
 	StructWriter                  io.Writer
 }
 
@@ -241,10 +372,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
-	}
-
 	if description := resource.Description; description != "" {
 		fprintf(e.SchemaWriter, "Description:%q,\n", description)
 	}
@@ -301,6 +428,8 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
@@ -309,6 +438,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		if isTopLevelAttribute {
 			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+			e.StructFields = append(e.StructFields, naming.ToCamelCase(name))
 		}
 
 		fprintf(e.SchemaWriter, ",\n")
@@ -897,11 +1027,24 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// withSchemaVersion sets the version of the generated schema.
+func withSchemaVersion(schema string, version int64) string {
+	if version == 0 {
+		return schema
+	}
+
+	return strings.Replace(schema, "schema.Schema{\n", fmt.Sprintf("schema.Schema{\nVersion:%d,\n", version), 1)
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
+	CreateBody                    string // Translated Plugin SDK CRUD handler bodies.
+	ReadBody                      string
+	UpdateBody                    string
+	DeleteBody                    string
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -917,17 +1060,32 @@ type templateData struct {
 	ImportProviderFrameworkTypes  bool
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	PriorSchema                   string // The Plugin SDK schema, for state upgrade.
+	PriorSchemaVersion            int64
 	Schema                        string
+	SchemaVersion                 int64
 	Struct                        string
+	StructFields                  []string
 	TFTypeName                    string // e.g. aws_instance
 }
 
+type testTemplateData struct {
+	Name            string // e.g. Instance
+	PackageName     string // e.g. ec2
+	ProviderVersion string // e.g. 6.0.0
+	ServiceName     string // e.g. EC2
+	TFTypeName      string // e.g. aws_instance
+}
+
 //go:embed datasource.gtpl
 var datasourceImpl string
 
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed migrationtest.gtpl
+var migrationTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string